@@ -0,0 +1,126 @@
+/*
+ * The MIT License
+ *
+ * Copyright 2018 kinsey40.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * File:   checkpoint.go
+ * Author: kinsey40
+ *
+ * Created on 19 October 2026, 23:55
+ *
+ * Checkpoint enables the state of a progress bar to be saved to a file whilst
+ * it runs, so that a program which is restarted (e.g. after a crash) resumes
+ * the progress bar from where it stopped.
+ *
+ */
+
+package pbar
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"time"
+)
+
+// SetCheckpoint saves the state of the progress bar (the description,
+// current value, total and elapsed time) to the file at most once per
+// interval, and whenever the progress bar is aborted. If the file exists
+// when the progress bar is Initialized, the progress bar resumes from the
+// saved state, so the elapsed time, rate and time remaining include the
+// previous run. The file is removed once the progress bar finishes. Children
+// of the progress bar are not saved, and an error saving the file does not
+// stop the progress bar.
+//
+// Default Value: "" (the state is not saved)
+func (itr *Iterator) SetCheckpoint(path string, interval time.Duration) {
+	itr.checkpointPath = path
+	itr.checkpointInterval = interval
+}
+
+// resume restores the state of the progress bar from the checkpoint, if it
+// exists. The lock must be held by the caller.
+func (itr *Iterator) resume() error {
+	data, err := ioutil.ReadFile(itr.checkpointPath)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	var s Snapshot
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("Checkpoint: %q is invalid: %v!", itr.checkpointPath, err)
+	}
+
+	start := itr.Values.GetStart()
+	if s.Current < start || s.Current > s.Total || s.Elapsed < 0 {
+		return fmt.Errorf("Checkpoint: %q is invalid, current: %f; total: %f!", itr.checkpointPath, s.Current, s.Total)
+	}
+
+	itr.Values.SetStop(s.Total)
+	itr.Values.SetCurrent(s.Current)
+	itr.Clock.AddElapsed(s.Elapsed)
+	itr.checkpointed = s.Elapsed
+	if itr.Settings.GetDescription() == "" {
+		itr.Settings.SetDescription(s.Description)
+	}
+
+	return nil
+}
+
+// checkpoint saves the state of the progress bar, when the interval has
+// passed since it was last saved or the progress bar has been aborted,
+// removing the checkpoint once the progress bar finishes. The lock must
+// be held by the caller.
+func (itr *Iterator) checkpoint() {
+	if itr.checkpointPath == "" || itr.Clock.IsStartTimeSet() != nil {
+		return
+	}
+
+	s := itr.snapshot()
+	switch {
+	case s.State == Finished:
+		os.Remove(itr.checkpointPath)
+	case s.State == Aborted || s.Elapsed-itr.checkpointed >= itr.checkpointInterval:
+		if writeCheckpoint(itr.checkpointPath, s) == nil {
+			itr.checkpointed = s.Elapsed
+		}
+	}
+}
+
+// writeCheckpoint writes the Snapshot to a temporary file, which then
+// replaces the checkpoint, so a crash whilst writing leaves the previous
+// checkpoint intact.
+func writeCheckpoint(path string, s Snapshot) error {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+
+	temporary := path + ".tmp"
+	if err := ioutil.WriteFile(temporary, data, 0644); err != nil {
+		return err
+	}
+
+	return os.Rename(temporary, path)
+}
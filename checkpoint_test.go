@@ -0,0 +1,164 @@
+/*
+ * The MIT License
+ *
+ * Copyright 2018 kinsey40.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * File:   checkpoint_test.go
+ * Author: kinsey40
+ *
+ * Created on 19 October 2026, 00:20
+ *
+ * The test file for checkpoint.go
+ *
+ */
+
+package pbar_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kinsey40/pbar"
+	"github.com/stretchr/testify/assert"
+)
+
+// readCheckpoint returns the Snapshot saved in the checkpoint
+func readCheckpoint(t *testing.T, path string) pbar.Snapshot {
+	var s pbar.Snapshot
+	data, err := ioutil.ReadFile(path)
+	assert.NoError(t, err, fmt.Sprintf("Unexpected error(%v) reading the checkpoint", err))
+	json.Unmarshal(data, &s)
+
+	return s
+}
+
+func TestCheckpointSave(t *testing.T) {
+	testCases := []struct {
+		interval        time.Duration
+		updates         int
+		abort           bool
+		expectedCurrent float64
+		expectedElapsed time.Duration
+		expectedState   pbar.State
+	}{
+		{0, 0, false, 0.0, time.Second, pbar.Running},
+		{0, 2, false, 2.0, time.Second * 3, pbar.Running},
+		{time.Second * 2, 2, false, 1.0, time.Second * 2, pbar.Running},
+		{time.Second * 2, 3, false, 3.0, time.Second * 4, pbar.Running},
+		{time.Hour, 2, true, 2.0, time.Second * 4, pbar.Aborted},
+	}
+
+	for _, testCase := range testCases {
+		dir, _ := ioutil.TempDir("", "pbar")
+		path := filepath.Join(dir, "checkpoint.json")
+		itr := createJSONBar(t, new(bytes.Buffer), nil, 5)
+		itr.SetDescription("Work")
+		itr.SetCheckpoint(path, testCase.interval)
+		itr.Initialize()
+		for index := 0; index < testCase.updates; index++ {
+			itr.Update()
+		}
+
+		if testCase.abort {
+			itr.Abort(errors.New("Failed!"))
+		}
+
+		s := readCheckpoint(t, path)
+		os.RemoveAll(dir)
+
+		assert.Equal(t, "Work", s.Description, fmt.Sprintf("Description expected: %v; got: %v", "Work", s.Description))
+		assert.Equal(t, 5.0, s.Total, fmt.Sprintf("Total expected: %v; got: %v", 5.0, s.Total))
+		assert.Equal(t, testCase.expectedCurrent, s.Current, fmt.Sprintf("Current expected: %v; got: %v", testCase.expectedCurrent, s.Current))
+		assert.Equal(t, testCase.expectedElapsed, s.Elapsed, fmt.Sprintf("Elapsed expected: %v; got: %v", testCase.expectedElapsed, s.Elapsed))
+		assert.Equal(t, testCase.expectedState, s.State, fmt.Sprintf("State expected: %v; got: %v", testCase.expectedState, s.State))
+	}
+}
+
+func TestCheckpointResume(t *testing.T) {
+	testCases := []struct {
+		checkpoint          string
+		description         string
+		expectError         bool
+		expectedDescription string
+		expectedCurrent     float64
+		expectedTotal       float64
+		expectedElapsed     time.Duration
+	}{
+		{"", "", false, "", 0.0, 4.0, time.Second},
+		{`{"desc":"Work","current":3,"total":4,"elapsed_ms":8000,"state":"running"}`, "", false, "Work", 3.0, 4.0, time.Second * 9},
+		{`{"desc":"Work","current":3,"total":6,"elapsed_ms":8000,"state":"aborted"}`, "Other", false, "Other", 3.0, 6.0, time.Second * 9},
+		{`{"desc":"Work","current":5,"total":4,"elapsed_ms":8000,"state":"running"}`, "", true, "", 0.0, 4.0, 0},
+		{`{"desc":"Work","current":-1,"total":4,"elapsed_ms":8000,"state":"running"}`, "", true, "", 0.0, 4.0, 0},
+		{`Not JSON`, "", true, "", 0.0, 4.0, 0},
+	}
+
+	for _, testCase := range testCases {
+		dir, _ := ioutil.TempDir("", "pbar")
+		path := filepath.Join(dir, "checkpoint.json")
+		if testCase.checkpoint != "" {
+			ioutil.WriteFile(path, []byte(testCase.checkpoint), 0644)
+		}
+
+		itr := createJSONBar(t, new(bytes.Buffer), nil, 4)
+		itr.SetDescription(testCase.description)
+		itr.SetCheckpoint(path, time.Hour)
+		err := itr.Initialize()
+		s := itr.Snapshot()
+		os.RemoveAll(dir)
+
+		if testCase.expectError {
+			assert.Error(t, err, fmt.Sprintf("Expected error not raised for: %q", testCase.checkpoint))
+			continue
+		}
+
+		assert.NoError(t, err, fmt.Sprintf("Unexpected error(%v) raised for: %q", err, testCase.checkpoint))
+		assert.Equal(t, testCase.expectedDescription, s.Description, fmt.Sprintf("Description expected: %v; got: %v", testCase.expectedDescription, s.Description))
+		assert.Equal(t, testCase.expectedCurrent, s.Current, fmt.Sprintf("Current expected: %v; got: %v", testCase.expectedCurrent, s.Current))
+		assert.Equal(t, testCase.expectedTotal, s.Total, fmt.Sprintf("Total expected: %v; got: %v", testCase.expectedTotal, s.Total))
+		assert.Equal(t, testCase.expectedElapsed, s.Elapsed, fmt.Sprintf("Elapsed expected: %v; got: %v", testCase.expectedElapsed, s.Elapsed))
+	}
+}
+
+func TestCheckpointFinish(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "pbar")
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "checkpoint.json")
+	ioutil.WriteFile(path, []byte(`{"desc":"Work","current":3,"total":4,"elapsed_ms":8000,"state":"running"}`), 0644)
+
+	itr := createJSONBar(t, new(bytes.Buffer), nil, 4)
+	itr.SetCheckpoint(path, 0)
+	itr.Initialize()
+	itr.Update()
+
+	s := itr.Snapshot()
+	_, err := os.Stat(path)
+	assert.Equal(t, pbar.Finished, s.State, fmt.Sprintf("State expected: %v; got: %v", pbar.Finished, s.State))
+	assert.Equal(t, time.Second*10, s.Elapsed, fmt.Sprintf("Elapsed expected: %v; got: %v", time.Second*10, s.Elapsed))
+	assert.Equal(t, 0.4, s.Rate, fmt.Sprintf("Rate expected: %v; got: %v", 0.4, s.Rate))
+	assert.True(t, os.IsNotExist(err), fmt.Sprintf("Checkpoint not removed: %v", err))
+}
@@ -0,0 +1,125 @@
+/*
+ * The MIT License
+ *
+ * Copyright 2018 kinsey40.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * File:   exec.go
+ * Author: kinsey40
+ *
+ * Created on 19 October 2026, 20:40
+ *
+ * Exec runs a command, displaying the progress it prints as a progress bar
+ * and exiting with the exit code of the command, e.g.
+ *
+ *     pbar exec -p '(?P<current>\d+) of (?P<total>\d+) files' -- rsync ...
+ *
+ */
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os/exec"
+	"strings"
+	"syscall"
+
+	"github.com/kinsey40/pbar"
+	"github.com/kinsey40/pbar/render"
+)
+
+// exitNotFound is the exit code when the command cannot be run
+const exitNotFound = 127
+
+// patternFlags collects the repeated -p flags
+type patternFlags []string
+
+// String returns the patterns, separated by commas
+func (p *patternFlags) String() string {
+	return strings.Join(*p, ",")
+}
+
+// Set adds a pattern
+func (p *patternFlags) Set(pattern string) error {
+	*p = append(*p, pattern)
+	return nil
+}
+
+// execCommand runs the command given by the arguments, displaying its
+// progress on stderr, along with the rest of its output (above the
+// progress bar), and returns the exit code of the command.
+func execCommand(args []string, stdin io.Reader, stderr io.Writer) int {
+	var patterns patternFlags
+	flags := flag.NewFlagSet("pbar exec", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Var(&patterns, "p", "a pattern matching the progress, with the groups current and total or percent (repeatable)")
+	description := flags.String("d", "", "the description displayed before the progress bar")
+	json := flags.Bool("json", false, "write the progress to stderr as JSON lines")
+	if err := flags.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	if flags.NArg() == 0 {
+		fmt.Fprintln(stderr, "A command is required, e.g. pbar exec -- make all")
+		return exitUsage
+	}
+
+	cmd := exec.Command(flags.Arg(0), flags.Args()[1:]...)
+	cmd.Stdin = stdin
+	c := pbar.NewCommand(cmd)
+	c.Write = &render.Writing{W: stderr}
+	if len(patterns) > 0 {
+		if err := c.SetProgressPatterns(patterns...); err != nil {
+			fmt.Fprintln(stderr, err)
+			return exitUsage
+		}
+	}
+
+	if *description != "" {
+		c.SetDescription(*description)
+	}
+
+	if *json {
+		c.SetJSONOutput(stderr)
+	}
+
+	return exitCode(c.Run(), stderr)
+}
+
+// exitCode returns the exit code of the command from the error of Run
+func exitCode(err error, stderr io.Writer) int {
+	if err == nil {
+		return exitSuccess
+	}
+
+	if exitErr, ok := err.(*exec.ExitError); ok {
+		if status, ok := exitErr.Sys().(syscall.WaitStatus); ok && status.ExitStatus() > 0 {
+			return status.ExitStatus()
+		}
+
+		return exitFailure
+	}
+
+	fmt.Fprintln(stderr, err)
+
+	return exitNotFound
+}
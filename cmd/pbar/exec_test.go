@@ -0,0 +1,95 @@
+/*
+ * The MIT License
+ *
+ * Copyright 2018 kinsey40.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * File:   exec_test.go
+ * Author: kinsey40
+ *
+ * Created on 19 October 2026, 20:40
+ *
+ * The test file for exec.go
+ *
+ */
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kinsey40/pbar/internal/pbartest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExecHelperProcess(t *testing.T) {
+	if os.Getenv("PBAR_HELPER_PROCESS") != "1" {
+		return
+	}
+
+	args := os.Args
+	for len(args) > 0 && args[0] != "--" {
+		args = args[1:]
+	}
+
+	for _, line := range args[2:] {
+		fmt.Fprint(os.Stdout, line)
+	}
+
+	code := 0
+	fmt.Sscanf(args[1], "%d", &code)
+	os.Exit(code)
+}
+
+func TestExec(t *testing.T) {
+	helper := []string{"--", os.Args[0], "-test.run=TestExecHelperProcess", "--"}
+	testCases := []struct {
+		args             []string
+		expectedCode     int
+		expectedProgress []string
+	}{
+		{append(helper, "0", "1/2\n", "Hello\n", "2/2\n"), exitSuccess, []string{"Hello\n", "2.0/2.0 100.0%"}},
+		{append(helper, "5", "1/2\n"), 5, []string{"1.0/2.0 50.0%", "cancelled"}},
+		{append([]string{"-p", `step (?P<current>\d+) of (?P<total>\d+)`, "-d", "Steps"}, append(helper, "0", "step 1 of 3\n", "1/2\n")...), exitSuccess, []string{"Steps:", "1.0/3.0 33.3%", "1/2\n"}},
+		{append([]string{"-json"}, append(helper, "0", "1/2\n")...), exitSuccess, []string{`"current":1,"total":2`, `"state":"finished"`}},
+		{[]string{"--", "pbar-command-which-does-not-exist"}, exitNotFound, []string{"pbar-command-which-does-not-exist"}},
+		{[]string{}, exitUsage, []string{"A command is required"}},
+		{append([]string{"-p", `(\d+)`}, helper...), exitUsage, []string{"no current or percent group"}},
+	}
+
+	os.Setenv("PBAR_HELPER_PROCESS", "1")
+	defer os.Unsetenv("PBAR_HELPER_PROCESS")
+
+	for _, testCase := range testCases {
+		pbartest.Stub(t, 100, time.Now)
+		stderr := new(bytes.Buffer)
+		code := run(append([]string{"exec"}, testCase.args...), strings.NewReader(""), new(bytes.Buffer), stderr)
+
+		assert.Equal(t, testCase.expectedCode, code, fmt.Sprintf("Exit code incorrect for %q expected: %v; got: %v (%q)", testCase.args, testCase.expectedCode, code, stderr.String()))
+		for _, progress := range testCase.expectedProgress {
+			assert.Contains(t, stderr.String(), progress, fmt.Sprintf("Output (%q) missing for %q: %q", progress, testCase.args, stderr.String()))
+		}
+	}
+}
@@ -0,0 +1,277 @@
+/*
+ * The MIT License
+ *
+ * Copyright 2018 kinsey40.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * File:   listen.go
+ * Author: kinsey40
+ *
+ * Created on 19 October 2026, 21:15
+ *
+ * Listen displays a progress bar driven by commands read from a Unix socket
+ * or a named pipe (FIFO), so that shell scripts can drive a progress bar:
+ *
+ *     pbar listen -fifo /tmp/progress -s 500 &
+ *     echo "add 3" > /tmp/progress
+ *
+ */
+
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/kinsey40/pbar"
+	"github.com/kinsey40/pbar/render"
+)
+
+// controller applies the commands read from the clients to the progress bar
+type controller struct {
+	bar pbar.Iterate
+}
+
+// notifyStop relays the signals which stop the listen command to the
+// channel, it is a variable so that it can be replaced in the tests.
+var notifyStop = func(signals chan<- os.Signal) {
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+}
+
+// listenCommand displays a progress bar, on stderr, which is driven by the
+// commands sent to the socket or FIFO. It returns once the progress bar
+// finishes (exitSuccess) or is aborted (exitFailure), which includes
+// being interrupted (SIGINT) or terminated (SIGTERM).
+func listenCommand(args []string, stderr io.Writer) int {
+	flags := flag.NewFlagSet("pbar listen", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	socket := flags.String("socket", "", "the path of the Unix socket to listen on")
+	fifo := flags.String("fifo", "", "the path of the named pipe to read from (created if it does not exist)")
+	size := flags.String("s", "100", "the initial total of the progress bar")
+	description := flags.String("d", "", "the description displayed before the progress bar")
+	json := flags.Bool("json", false, "write the progress to stderr as JSON lines")
+	if err := flags.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	if (*socket == "") == (*fifo == "") {
+		fmt.Fprintln(stderr, "Exactly one of -socket or -fifo is required!")
+		return exitUsage
+	}
+
+	total, err := parseSize(*size)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return exitUsage
+	}
+
+	p, _ := pbar.Pbar(total)
+	p.(*pbar.Iterator).Write = &render.Writing{W: stderr}
+	if *description != "" {
+		p.SetDescription(*description)
+	}
+
+	if *json {
+		p.SetJSONOutput(stderr)
+	}
+
+	lines := make(chan string)
+	var stop func()
+	if *socket != "" {
+		stop, err = listenSocket(*socket, lines)
+	} else {
+		stop, err = listenFIFO(*fifo, lines)
+	}
+
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return exitFailure
+	}
+	defer stop()
+
+	p.Initialize()
+	signals := make(chan os.Signal, 1)
+	notifyStop(signals)
+	defer signal.Stop(signals)
+
+	c := &controller{bar: p}
+	for {
+		select {
+		case sig := <-signals:
+			err := fmt.Errorf("Stopped by signal: %v!", sig)
+			p.Abort(err)
+			fmt.Fprintln(stderr, err)
+			return exitFailure
+		case line, ok := <-lines:
+			if !ok {
+				return exitSuccess
+			}
+
+			if err := c.apply(line); err != nil {
+				p.Println(err)
+			}
+		}
+
+		switch p.Snapshot().State {
+		case pbar.Finished:
+			return exitSuccess
+		case pbar.Aborted:
+			return exitFailure
+		}
+	}
+}
+
+// apply performs the command on the progress bar, the commands are:
+//
+//	total N      set the total of the progress bar
+//	add [N]      move the progress bar forward by N (default 1)
+//	set N        move the progress bar to N
+//	desc TEXT    set the description (which may be quoted)
+//	print TEXT   print the text above the progress bar
+//	finish       complete the progress bar
+//	abort [TEXT] abort the progress bar
+func (c *controller) apply(line string) error {
+	fields := strings.Fields(line)
+	if len(fields) == 0 {
+		return nil
+	}
+
+	command := fields[0]
+	argument := strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(line), command))
+	switch command {
+	case "total":
+		total, err := parseNumber(argument)
+		if err != nil {
+			return err
+		}
+
+		if total <= 0.0 {
+			return fmt.Errorf("Total: %q must be greater than zero!", argument)
+		}
+
+		return c.bar.SetTotal(total)
+	case "add":
+		amount := 1.0
+		if argument != "" {
+			var err error
+			if amount, err = parseNumber(argument); err != nil {
+				return err
+			}
+		}
+
+		return c.bar.Add(amount)
+	case "set":
+		current, err := parseNumber(argument)
+		if err != nil {
+			return err
+		}
+
+		return c.bar.Add(current - c.bar.Snapshot().Current)
+	case "desc":
+		c.bar.SetDescription(unquote(argument))
+		return c.bar.Add(0.0)
+	case "print":
+		return c.bar.Println(unquote(argument))
+	case "finish":
+		s := c.bar.Snapshot()
+		return c.bar.Add(s.Total - s.Current)
+	case "abort":
+		message := unquote(argument)
+		if message == "" {
+			message = pbar.AbortedMessage
+		}
+
+		return c.bar.Abort(errors.New(message))
+	}
+
+	return fmt.Errorf("Command: %q is not recognised!", command)
+}
+
+// parseNumber parses the number argument of a command
+func parseNumber(argument string) (float64, error) {
+	number, err := strconv.ParseFloat(argument, 64)
+	if err != nil {
+		return 0.0, fmt.Errorf("Number: %q is invalid!", argument)
+	}
+
+	return number, nil
+}
+
+// unquote removes the quotes around the text, if it is quoted
+func unquote(text string) string {
+	if unquoted, err := strconv.Unquote(text); err == nil {
+		return unquoted
+	}
+
+	return text
+}
+
+// listenSocket accepts connections on the Unix socket, sending each line
+// read from the connections to the channel. The returned function stops
+// listening and removes the socket.
+func listenSocket(path string, lines chan<- string) (func(), error) {
+	listener, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, err
+	}
+
+	done := make(chan struct{})
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+
+			go func() {
+				defer conn.Close()
+				readLines(conn, lines, done)
+			}()
+		}
+	}()
+
+	return func() {
+		close(done)
+		listener.Close()
+		os.Remove(path)
+	}, nil
+}
+
+// readLines sends each line read from the reader to the channel,
+// until the reader is exhausted or done is closed.
+func readLines(r io.Reader, lines chan<- string, done <-chan struct{}) {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		select {
+		case lines <- scanner.Text():
+		case <-done:
+			return
+		}
+	}
+}
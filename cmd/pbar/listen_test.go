@@ -0,0 +1,247 @@
+/*
+ * The MIT License
+ *
+ * Copyright 2018 kinsey40.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * File:   listen_test.go
+ * Author: kinsey40
+ *
+ * Created on 19 October 2026, 21:15
+ *
+ * The test file for listen.go
+ *
+ */
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"runtime"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/kinsey40/pbar"
+	"github.com/kinsey40/pbar/internal/pbartest"
+	"github.com/kinsey40/pbar/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestControllerApply(t *testing.T) {
+	testCases := []struct {
+		commands        []string
+		expectError     bool
+		expectedCurrent float64
+		expectedTotal   float64
+		expectedState   pbar.State
+	}{
+		{[]string{"add"}, false, 1.0, 10.0, pbar.Running},
+		{[]string{"add 2.5", "add 3"}, false, 5.5, 10.0, pbar.Running},
+		{[]string{"set 7"}, false, 7.0, 10.0, pbar.Running},
+		{[]string{"total 500", "add 3"}, false, 3.0, 500.0, pbar.Running},
+		{[]string{"desc \"stage 2\"", "print hello", ""}, false, 0.0, 10.0, pbar.Running},
+		{[]string{"add 2", "finish"}, false, 10.0, 10.0, pbar.Finished},
+		{[]string{"add 2", "abort failed"}, false, 2.0, 10.0, pbar.Aborted},
+		{[]string{"add x"}, true, 0.0, 10.0, pbar.Running},
+		{[]string{"total"}, true, 0.0, 10.0, pbar.Running},
+		{[]string{"total 0"}, true, 0.0, 10.0, pbar.Running},
+		{[]string{"add 2", "total -1"}, true, 2.0, 10.0, pbar.Running},
+		{[]string{"jump 3"}, true, 0.0, 10.0, pbar.Running},
+	}
+
+	for _, testCase := range testCases {
+		pbartest.Stub(t, 100, pbartest.At(0))
+		p, _ := pbar.Pbar(10)
+		p.(*pbar.Iterator).Write = &render.Writing{W: new(bytes.Buffer)}
+		p.Initialize()
+
+		c := &controller{bar: p}
+		var err error
+		for _, command := range testCase.commands {
+			if commandErr := c.apply(command); commandErr != nil {
+				err = commandErr
+			}
+		}
+
+		s := p.Snapshot()
+		if testCase.expectError {
+			assert.Error(t, err, fmt.Sprintf("Expected error not raised for: %q", testCase.commands))
+		} else {
+			assert.NoError(t, err, fmt.Sprintf("Unexpected error(%v) raised for: %q", err, testCase.commands))
+		}
+
+		assert.Equal(t, testCase.expectedCurrent, s.Current, fmt.Sprintf("Current incorrect for %q expected: %v; got: %v", testCase.commands, testCase.expectedCurrent, s.Current))
+		assert.Equal(t, testCase.expectedTotal, s.Total, fmt.Sprintf("Total incorrect for %q expected: %v; got: %v", testCase.commands, testCase.expectedTotal, s.Total))
+		assert.Equal(t, testCase.expectedState, s.State, fmt.Sprintf("State incorrect for %q expected: %v; got: %v", testCase.commands, testCase.expectedState, s.State))
+	}
+}
+
+// startListen runs the listen command in the background, returning
+// a channel receiving its exit code and the buffer of its output.
+func startListen(t *testing.T, args ...string) (chan int, *bytes.Buffer) {
+	pbartest.Stub(t, 100, time.Now)
+	stderr := new(bytes.Buffer)
+	code := make(chan int, 1)
+	go func() {
+		code <- run(append([]string{"listen"}, args...), nil, nil, stderr)
+	}()
+
+	return code, stderr
+}
+
+// waitForExit returns the exit code, or fails the test after a timeout
+func waitForExit(t *testing.T, code chan int) int {
+	select {
+	case c := <-code:
+		return c
+	case <-time.After(time.Second * 5):
+		t.Fatalf("The listen command did not exit")
+	}
+
+	return -1
+}
+
+func TestListenSocket(t *testing.T) {
+	testCases := []struct {
+		commands         []string
+		expectedCode     int
+		expectedProgress []string
+	}{
+		{[]string{"total 4", "desc \"Stage 2\"", "add 3", "print Hello", "add"}, exitSuccess, []string{"Stage 2:", "Hello\n", "4.0/4.0 100.0%"}},
+		{[]string{"add 2", "jump", "abort"}, exitFailure, []string{"is not recognised", "cancelled"}},
+		{[]string{"total 0", "total 2", "add 2"}, exitSuccess, []string{"must be greater than zero", "2.0/2.0 100.0%"}},
+	}
+
+	for _, testCase := range testCases {
+		dir, _ := ioutil.TempDir("", "pbar")
+		path := filepath.Join(dir, "progress.sock")
+		code, stderr := startListen(t, "-socket", path)
+
+		var conn net.Conn
+		var err error
+		for attempt := 0; attempt < 100; attempt++ {
+			if conn, err = net.Dial("unix", path); err == nil {
+				break
+			}
+			time.Sleep(time.Millisecond * 10)
+		}
+		assert.NoError(t, err, fmt.Sprintf("Unexpected error(%v) connecting to the socket", err))
+
+		for _, command := range testCase.commands {
+			fmt.Fprintln(conn, command)
+		}
+
+		exitCode := waitForExit(t, code)
+		conn.Close()
+		_, statErr := os.Stat(path)
+		os.RemoveAll(dir)
+
+		assert.Equal(t, testCase.expectedCode, exitCode, fmt.Sprintf("Exit code incorrect expected: %v; got: %v", testCase.expectedCode, exitCode))
+		assert.True(t, os.IsNotExist(statErr), fmt.Sprintf("Socket not removed: %v", statErr))
+		for _, progress := range testCase.expectedProgress {
+			assert.Contains(t, stderr.String(), progress, fmt.Sprintf("Output (%q) missing: %q", progress, stderr.String()))
+		}
+	}
+}
+
+func TestListenFIFO(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("Named pipes are not supported on Windows")
+	}
+
+	dir, _ := ioutil.TempDir("", "pbar")
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "progress")
+	code, stderr := startListen(t, "-fifo", path, "-s", "3")
+
+	for _, command := range []string{"add", "add", "add"} {
+		var file *os.File
+		var err error
+		for attempt := 0; attempt < 100; attempt++ {
+			if file, err = os.OpenFile(path, os.O_WRONLY, 0); err == nil {
+				break
+			}
+			time.Sleep(time.Millisecond * 10)
+		}
+		assert.NoError(t, err, fmt.Sprintf("Unexpected error(%v) opening the FIFO", err))
+
+		fmt.Fprintln(file, command)
+		file.Close()
+	}
+
+	exitCode := waitForExit(t, code)
+	_, statErr := os.Stat(path)
+
+	assert.Equal(t, exitSuccess, exitCode, fmt.Sprintf("Exit code incorrect expected: %v; got: %v", exitSuccess, exitCode))
+	assert.Contains(t, stderr.String(), "3.0/3.0 100.0%", fmt.Sprintf("Progress not finished: %q", stderr.String()))
+	assert.True(t, os.IsNotExist(statErr), fmt.Sprintf("FIFO not removed: %v", statErr))
+}
+
+func TestListenSignal(t *testing.T) {
+	testCases := []os.Signal{os.Interrupt, syscall.SIGTERM}
+
+	for _, sig := range testCases {
+		signals := make(chan chan<- os.Signal, 1)
+		notify := notifyStop
+		notifyStop = func(c chan<- os.Signal) { signals <- c }
+
+		dir, _ := ioutil.TempDir("", "pbar")
+		path := filepath.Join(dir, "progress.sock")
+		code, stderr := startListen(t, "-socket", path, "-d", "Work")
+
+		select {
+		case c := <-signals:
+			c <- sig
+		case <-time.After(time.Second * 5):
+			t.Fatalf("The listen command did not wait for signals")
+		}
+
+		exitCode := waitForExit(t, code)
+		notifyStop = notify
+		_, statErr := os.Stat(path)
+		os.RemoveAll(dir)
+
+		expected := fmt.Sprintf("Stopped by signal: %v!", sig)
+		assert.Equal(t, exitFailure, exitCode, fmt.Sprintf("Exit code incorrect for %v expected: %v; got: %v", sig, exitFailure, exitCode))
+		assert.True(t, os.IsNotExist(statErr), fmt.Sprintf("Socket not removed after %v: %v", sig, statErr))
+		assert.Contains(t, stderr.String(), expected, fmt.Sprintf("Output (%q) missing: %q", expected, stderr.String()))
+	}
+}
+
+func TestListenUsage(t *testing.T) {
+	testCases := [][]string{
+		{},
+		{"-socket", "a", "-fifo", "b"},
+		{"-socket", "a", "-s", "x"},
+		{"-socket", "a", "-s", "0"},
+	}
+
+	for _, args := range testCases {
+		stderr := new(bytes.Buffer)
+		code := run(append([]string{"listen"}, args...), nil, nil, stderr)
+		assert.Equal(t, exitUsage, code, fmt.Sprintf("Exit code incorrect for %q expected: %v; got: %v", args, exitUsage, code))
+	}
+}
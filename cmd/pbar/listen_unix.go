@@ -0,0 +1,72 @@
+//go:build !windows
+// +build !windows
+
+/*
+ * The MIT License
+ *
+ * Copyright 2018 kinsey40.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * File:   listen_unix.go
+ * Author: kinsey40
+ *
+ * Created on 19 October 2026, 21:15
+ *
+ * The named pipe (FIFO) listener of the listen command.
+ *
+ */
+
+package main
+
+import (
+	"os"
+	"syscall"
+)
+
+// listenFIFO sends each line written to the named pipe to the channel,
+// creating the named pipe if it does not exist. The returned function
+// stops reading and removes the named pipe, if it was created.
+func listenFIFO(path string, lines chan<- string) (func(), error) {
+	created := false
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		if err := syscall.Mkfifo(path, 0600); err != nil {
+			return nil, err
+		}
+		created = true
+	}
+
+	// The named pipe is opened for reading and writing, so the end of the
+	// file is not reached each time a writer closes it (e.g. after an echo).
+	file, err := os.OpenFile(path, os.O_RDWR, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	done := make(chan struct{})
+	go readLines(file, lines, done)
+
+	return func() {
+		close(done)
+		file.Close()
+		if created {
+			os.Remove(path)
+		}
+	}, nil
+}
@@ -0,0 +1,84 @@
+/*
+ * The MIT License
+ *
+ * Copyright 2018 kinsey40.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * File:   main.go
+ * Author: kinsey40
+ *
+ * Created on 19 October 2026, 19:30
+ *
+ * The pbar command copies its input to its output whilst displaying the
+ * progress on stderr (in the style of pv), e.g.
+ *
+ *     pbar -s 1G < disk.img | gzip > disk.img.gz
+ *
+ */
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/kinsey40/pbar/render"
+)
+
+// The exit codes of the command
+const (
+	exitSuccess = 0
+	exitFailure = 1
+	exitUsage   = 2
+)
+
+func main() {
+	render.GetTerminal = os.Stderr.Fd
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// run performs the command given by the arguments, returning the exit code
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) > 0 {
+		switch args[0] {
+		case "exec":
+			return execCommand(args[1:], stdin, stderr)
+		case "listen":
+			return listenCommand(args[1:], stderr)
+		case "replay":
+			return replayCommand(args[1:], stdin, stderr)
+		case "help":
+			fmt.Fprintln(stderr, usage)
+			return exitSuccess
+		}
+	}
+
+	return pipe(args, stdin, stdout, stderr)
+}
+
+// usage describes the commands
+const usage = `Usage:
+  pbar [flags] < input > output    copy the input to the output, displaying the progress
+  pbar exec [flags] -- command     run the command, displaying the progress it prints
+  pbar listen [flags]              display a progress bar driven by commands sent to a socket or FIFO
+  pbar replay [flags] [recording]  replay a recorded progress bar
+
+Run "pbar -h", "pbar exec -h", "pbar listen -h" or "pbar replay -h" for the flags of each command.`
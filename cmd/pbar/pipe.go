@@ -0,0 +1,286 @@
+/*
+ * The MIT License
+ *
+ * Copyright 2018 kinsey40.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * File:   pipe.go
+ * Author: kinsey40
+ *
+ * Created on 19 October 2026, 19:30
+ *
+ * Pipe copies the input to the output, displaying the progress of the copy
+ * as a progress bar (or a spinner if the size of the input is not known).
+ *
+ */
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/kinsey40/pbar"
+	"github.com/kinsey40/pbar/render"
+)
+
+// chunkSize is the maximum number of bytes copied at once
+var chunkSize = 32 * 1024
+
+// pipeOptions holds the flags of the pipe command
+type pipeOptions struct {
+	size        string
+	lines       bool
+	description string
+	limit       string
+	interval    time.Duration
+	quiet       bool
+	json        bool
+}
+
+// meter displays the progress of the copy, using either
+// a progress bar, a spinner or nothing (when quiet).
+type meter struct {
+	bar     pbar.Iterate
+	spinner *pbar.Spinner
+	unit    render.Unit
+	start   time.Time
+	copied  float64
+}
+
+// pipe copies the input to the output, displaying the progress on stderr
+func pipe(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	options := pipeOptions{}
+	flags := flag.NewFlagSet("pbar", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.StringVar(&options.size, "s", "", "the expected size of the input, e.g. 1G (lines with -l)")
+	flags.BoolVar(&options.lines, "l", false, "count lines rather than bytes")
+	flags.StringVar(&options.description, "d", "", "the description displayed before the progress bar")
+	flags.StringVar(&options.limit, "L", "", "limit the rate to the size per second, e.g. 1M (lines with -l)")
+	flags.DurationVar(&options.interval, "i", time.Millisecond*100, "the interval between updates of the progress bar")
+	flags.BoolVar(&options.quiet, "q", false, "do not display the progress")
+	flags.BoolVar(&options.json, "json", false, "write the progress to stderr as JSON lines")
+	if err := flags.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	if flags.NArg() > 0 {
+		fmt.Fprintf(stderr, "Unexpected arguments: %v!\n", flags.Args())
+		return exitUsage
+	}
+
+	m, limit, err := options.meter(stdin, stderr)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return exitUsage
+	}
+
+	if err := m.copy(stdin, stdout, options.lines, limit, options.interval); err != nil {
+		fmt.Fprintln(stderr, err)
+		return exitFailure
+	}
+
+	return exitSuccess
+}
+
+// meter creates the meter described by the options, and
+// returns the rate limit (zero if there is no limit).
+func (options pipeOptions) meter(stdin io.Reader, stderr io.Writer) (*meter, float64, error) {
+	var err error
+	limit := 0.0
+	if options.limit != "" {
+		if limit, err = parseSize(options.limit); err != nil {
+			return nil, 0.0, err
+		}
+	}
+
+	total := 0.0
+	if options.size != "" {
+		if total, err = parseSize(options.size); err != nil {
+			return nil, 0.0, err
+		}
+	} else if !options.lines {
+		total = inputSize(stdin)
+	}
+
+	m := &meter{unit: render.UnitBytes, start: time.Now()}
+	if options.lines {
+		m.unit = render.UnitNone
+	}
+
+	switch {
+	case options.quiet:
+		return m, limit, nil
+	case total > 0.0:
+		p, err := pbar.Pbar(total)
+		if err != nil {
+			return nil, 0.0, err
+		}
+
+		p.SetUnit(m.unit)
+		p.(*pbar.Iterator).Write = &render.Writing{W: stderr}
+		if options.description != "" {
+			p.SetDescription(options.description)
+		}
+
+		if options.json {
+			p.SetJSONOutput(stderr)
+		}
+
+		m.bar = p
+	case options.json:
+		return nil, 0.0, errors.New("The size (-s) is required for JSON output, it cannot be found from the input!")
+	default:
+		s, _ := pbar.NewSpinner(pbar.DefaultSpinnerFrames)
+		m.spinner = s.(*pbar.Spinner)
+		m.spinner.Write = &render.Writing{W: stderr}
+		m.spinner.SetDescription(options.description)
+	}
+
+	return m, limit, nil
+}
+
+// copy copies the input to the output, updating the meter at most once
+// per interval. The copy is slowed so the rate (per second) does not
+// exceed the limit, unless the limit is zero.
+func (m *meter) copy(stdin io.Reader, stdout io.Writer, lines bool, limit float64, interval time.Duration) error {
+	size := chunkSize
+	if !lines && limit > 0.0 && limit < float64(size) {
+		size = int(limit)
+	}
+
+	if err := m.begin(); err != nil {
+		return err
+	}
+
+	buffer := make([]byte, size)
+	pending := 0.0
+	updated := m.start
+	for {
+		n, err := stdin.Read(buffer)
+		if n > 0 {
+			if _, err := stdout.Write(buffer[:n]); err != nil {
+				m.end(err)
+				return err
+			}
+
+			amount := float64(n)
+			if lines {
+				amount = float64(bytes.Count(buffer[:n], []byte("\n")))
+			}
+
+			m.copied += amount
+			pending += amount
+			if now := time.Now(); now.Sub(updated) >= interval {
+				m.add(pending)
+				pending = 0.0
+				updated = now
+			}
+
+			if limit > 0.0 {
+				expected := time.Duration(m.copied / limit * float64(time.Second))
+				if elapsed := time.Since(m.start); elapsed < expected {
+					time.Sleep(expected - elapsed)
+				}
+			}
+		}
+
+		if err == io.EOF {
+			m.add(pending)
+			return m.end(nil)
+		}
+
+		if err != nil {
+			m.end(err)
+			return err
+		}
+	}
+}
+
+// begin starts displaying the progress
+func (m *meter) begin() error {
+	switch {
+	case m.bar != nil:
+		m.bar.Initialize()
+	case m.spinner != nil:
+		m.spinner.SetMessage(m.unit.FormatValue(0.0))
+		return m.spinner.Start()
+	}
+
+	return nil
+}
+
+// add moves the progress forward by the amount copied since the last update
+func (m *meter) add(amount float64) {
+	switch {
+	case m.bar != nil:
+		m.bar.Add(amount)
+	case m.spinner != nil:
+		rate := -1.0
+		if elapsed := time.Since(m.start).Seconds(); elapsed > 0.0 {
+			rate = m.copied / elapsed
+		}
+
+		m.spinner.SetMessage(fmt.Sprintf("%s (%s)", m.unit.FormatValue(m.copied), m.unit.FormatRate(rate)))
+	}
+}
+
+// end stops displaying the progress, the progress bar is finished at the
+// amount copied if the copy succeeded, otherwise it is aborted. Nothing
+// can be finished at a total of zero, so if nothing was copied the
+// progress bar is finished at its size.
+func (m *meter) end(err error) error {
+	switch {
+	case m.bar != nil && err != nil:
+		return m.bar.Abort(err)
+	case m.bar != nil && m.copied == 0.0:
+		s := m.bar.Snapshot()
+		return m.bar.Add(s.Total - s.Current)
+	case m.bar != nil:
+		return m.bar.SetTotal(m.copied)
+	case m.spinner != nil && err != nil:
+		return m.spinner.Failure(err.Error())
+	case m.spinner != nil:
+		return m.spinner.Success("")
+	}
+
+	return nil
+}
+
+// inputSize returns the size of the input if it is a regular
+// file, otherwise the size is not known and zero is returned.
+func inputSize(stdin io.Reader) float64 {
+	file, ok := stdin.(*os.File)
+	if !ok {
+		return 0.0
+	}
+
+	info, err := file.Stat()
+	if err != nil || !info.Mode().IsRegular() {
+		return 0.0
+	}
+
+	return float64(info.Size())
+}
@@ -0,0 +1,143 @@
+/*
+ * The MIT License
+ *
+ * Copyright 2018 kinsey40.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * File:   pipe_test.go
+ * Author: kinsey40
+ *
+ * Created on 19 October 2026, 19:30
+ *
+ * The test file for pipe.go
+ *
+ */
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kinsey40/pbar"
+	"github.com/kinsey40/pbar/internal/pbartest"
+	"github.com/kinsey40/pbar/render"
+	"github.com/stretchr/testify/assert"
+)
+
+type failingWriter struct{}
+
+func (failingWriter) Write(p []byte) (int, error) {
+	return 0, errors.New("Write failed!")
+}
+
+func TestPipe(t *testing.T) {
+	successSymbol := pbar.DefaultSuccessSymbol
+	if !render.SupportsUnicode() {
+		successSymbol = pbar.ASCIISuccessSymbol
+	}
+
+	testCases := []struct {
+		args             []string
+		input            string
+		expectedCode     int
+		expectedOutput   string
+		expectedProgress []string
+	}{
+		{[]string{"-i", "0", "-s", "10"}, "0123456789", exitSuccess, "0123456789", []string{"10 B/10 B 100.0%"}},
+		{[]string{"-i", "0", "-s", "20", "-d", "Copy"}, "0123456789", exitSuccess, "0123456789", []string{"Copy:", "10 B/10 B 100.0%"}},
+		{[]string{"-i", "0", "-s", "5"}, "0123456789", exitSuccess, "0123456789", []string{"5 B/5 B 100.0%"}},
+		{[]string{"-i", "0", "-l", "-s", "3"}, "a\nb\nc\n", exitSuccess, "a\nb\nc\n", []string{"0.0/3.0 0.0%", "3.0/3.0 100.0%"}},
+		{[]string{"-i", "0"}, "0123456789", exitSuccess, "0123456789", []string{"10 B (", successSymbol}},
+		{[]string{"-i", "0", "-json", "-s", "10"}, "0123456789", exitSuccess, "0123456789", []string{`"current":10,"total":10`, `"state":"finished"`}},
+		{[]string{"-i", "0", "-s", "10"}, "", exitSuccess, "", []string{"10 B/10 B 100.0%"}},
+		{[]string{"-i", "0", "-json", "-s", "10"}, "", exitSuccess, "", []string{`"state":"finished"`}},
+		{[]string{"-i", "0", "-l", "-s", "3"}, "abc", exitSuccess, "abc", []string{"3.0/3.0 100.0%"}},
+		{[]string{"-q", "-s", "10"}, "0123456789", exitSuccess, "0123456789", []string{}},
+		{[]string{"-json"}, "0123456789", exitUsage, "", []string{"required"}},
+		{[]string{"-s", "Hello"}, "0123456789", exitUsage, "", []string{"invalid"}},
+		{[]string{"-x"}, "0123456789", exitUsage, "", []string{"flag provided but not defined"}},
+		{[]string{"input.txt"}, "0123456789", exitUsage, "", []string{"Unexpected arguments"}},
+	}
+
+	for _, testCase := range testCases {
+		pbartest.Stub(t, 100, time.Now)
+		stdout := new(bytes.Buffer)
+		stderr := new(bytes.Buffer)
+		code := run(testCase.args, strings.NewReader(testCase.input), stdout, stderr)
+
+		assert.Equal(t, testCase.expectedCode, code, fmt.Sprintf("Exit code incorrect for %v expected: %v; got: %v (%q)", testCase.args, testCase.expectedCode, code, stderr.String()))
+		assert.Equal(t, testCase.expectedOutput, stdout.String(), fmt.Sprintf("Output incorrect for %v: %q", testCase.args, stdout.String()))
+		for _, progress := range testCase.expectedProgress {
+			assert.Contains(t, stderr.String(), progress, fmt.Sprintf("Progress (%v) missing for %v: %q", progress, testCase.args, stderr.String()))
+		}
+
+		if len(testCase.expectedProgress) == 0 {
+			assert.Empty(t, stderr.String(), fmt.Sprintf("Progress displayed for %v: %q", testCase.args, stderr.String()))
+		}
+	}
+}
+
+func TestPipeWriteError(t *testing.T) {
+	pbartest.Stub(t, 100, time.Now)
+	stderr := new(bytes.Buffer)
+	code := run([]string{"-s", "10"}, strings.NewReader("0123456789"), failingWriter{}, stderr)
+
+	assert.Equal(t, exitFailure, code, fmt.Sprintf("Exit code incorrect expected: %v; got: %v", exitFailure, code))
+	assert.Contains(t, stderr.String(), "Write failed!", fmt.Sprintf("Error missing: %q", stderr.String()))
+}
+
+func TestPipeRateLimit(t *testing.T) {
+	pbartest.Stub(t, 100, time.Now)
+	start := time.Now()
+	code := run([]string{"-q", "-L", "1K"}, strings.NewReader(strings.Repeat("x", 512)), new(bytes.Buffer), new(bytes.Buffer))
+	elapsed := time.Since(start)
+
+	assert.Equal(t, exitSuccess, code, fmt.Sprintf("Exit code incorrect expected: %v; got: %v", exitSuccess, code))
+	assert.True(t, elapsed >= time.Millisecond*400, fmt.Sprintf("Rate not limited, copied in: %v", elapsed))
+}
+
+func TestInputSize(t *testing.T) {
+	file, _ := ioutil.TempFile("", "pbar")
+	defer os.Remove(file.Name())
+	file.WriteString("0123456789")
+	file.Seek(0, 0)
+
+	testCases := []struct {
+		input        io.Reader
+		expectedSize float64
+	}{
+		{file, 10.0},
+		{strings.NewReader("0123456789"), 0.0},
+	}
+
+	for _, testCase := range testCases {
+		size := inputSize(testCase.input)
+
+		assert.Equal(t, testCase.expectedSize, size, fmt.Sprintf("Size incorrect expected: %v; got: %v", testCase.expectedSize, size))
+	}
+}
@@ -0,0 +1,92 @@
+/*
+ * The MIT License
+ *
+ * Copyright 2018 kinsey40.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * File:   replay.go
+ * Author: kinsey40
+ *
+ * Created on 19 October 2026, 23:10
+ *
+ * Replay draws a progress bar recorded using SetRecorder (or written as JSON
+ * lines using -json), at the speed it was recorded or faster, e.g.
+ *
+ *     pbar replay -speed 10 progress.jsonl
+ *
+ */
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/kinsey40/pbar"
+	"github.com/kinsey40/pbar/render"
+)
+
+// replayCommand replays the recording named by the arguments, or read
+// from stdin, drawing the progress bar on stderr.
+func replayCommand(args []string, stdin io.Reader, stderr io.Writer) int {
+	flags := flag.NewFlagSet("pbar replay", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	speed := flags.Float64("speed", 1.0, "how many times faster than recorded to replay, 0 replays without waiting")
+	if err := flags.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	if flags.NArg() > 1 {
+		fmt.Fprintln(stderr, "A single recording is required, e.g. pbar replay progress.jsonl")
+		return exitUsage
+	}
+
+	if flags.NArg() == 1 && flags.Arg(0) != "-" {
+		file, err := os.Open(flags.Arg(0))
+		if err != nil {
+			fmt.Fprintln(stderr, err)
+			return exitFailure
+		}
+		defer file.Close()
+
+		stdin = file
+	}
+
+	rp, err := pbar.NewReplay(stdin)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return exitFailure
+	}
+
+	if err := rp.SetSpeed(*speed); err != nil {
+		fmt.Fprintln(stderr, err)
+		return exitUsage
+	}
+
+	rp.Write = &render.Writing{W: stderr}
+	if err := rp.Run(); err != nil {
+		fmt.Fprintln(stderr, err)
+		return exitFailure
+	}
+
+	return exitSuccess
+}
@@ -0,0 +1,99 @@
+/*
+ * The MIT License
+ *
+ * Copyright 2018 kinsey40.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * File:   replay_test.go
+ * Author: kinsey40
+ *
+ * Created on 19 October 2026, 23:30
+ *
+ * The test file for replay.go
+ *
+ */
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kinsey40/pbar/internal/pbartest"
+	"github.com/kinsey40/pbar/render"
+	"github.com/stretchr/testify/assert"
+)
+
+const testRecording = `{"pbar_recording":1,"start":0,"unit":0,"line_size":10,"lparen":"|","rparen":"|","finished":"#","current":"#","remaining":" "}
+{"desc":"Work","current":0,"total":2,"percent":0,"elapsed_ms":1000,"eta_ms":null,"rate":null,"state":"running"}
+{"desc":"Work","current":1,"total":2,"percent":50,"elapsed_ms":2000,"eta_ms":2000,"rate":0.5,"state":"running"}
+{"desc":"Work","current":2,"total":2,"percent":100,"elapsed_ms":3000,"eta_ms":0,"rate":0.6666666666666666,"state":"finished"}
+`
+
+func TestReplay(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "pbar")
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "progress.jsonl")
+	ioutil.WriteFile(path, []byte(testRecording), 0644)
+
+	testCases := []struct {
+		args             []string
+		input            string
+		expectedCode     int
+		expectedProgress string
+	}{
+		{[]string{"-speed", "0", path}, "", exitSuccess, "Work: |##########| 2.0/2.0 100.0% [elapsed: 00m:03s, left: 00m:00s, 0.67 iters/sec]"},
+		{[]string{"-speed", "0", "-"}, testRecording, exitSuccess, "2.0/2.0 100.0%"},
+		{[]string{"-speed", "0"}, testRecording, exitSuccess, "2.0/2.0 100.0%"},
+		{[]string{"-speed", "1000", path}, "", exitSuccess, "2.0/2.0 100.0%"},
+		{[]string{filepath.Join(dir, "missing.jsonl")}, "", exitFailure, "missing.jsonl"},
+		{[]string{}, "Not JSON", exitFailure, "Line: 1 of the recording is invalid"},
+		{[]string{"-speed", "-1", path}, "", exitUsage, "must not be negative"},
+		{[]string{path, path}, "", exitUsage, "A single recording is required"},
+		{[]string{"-speed", "x"}, "", exitUsage, "invalid value"},
+	}
+
+	for _, testCase := range testCases {
+		pbartest.Stub(t, 100, time.Now)
+		stderr := new(bytes.Buffer)
+		code := run(append([]string{"replay"}, testCase.args...), strings.NewReader(testCase.input), nil, stderr)
+
+		assert.Equal(t, testCase.expectedCode, code, fmt.Sprintf("Exit code incorrect for %q expected: %v; got: %v", testCase.args, testCase.expectedCode, code))
+		assert.Contains(t, stderr.String(), testCase.expectedProgress, fmt.Sprintf("Output (%q) missing: %q", testCase.expectedProgress, stderr.String()))
+	}
+}
+
+func TestReplayWithoutTerminal(t *testing.T) {
+	pbartest.Stub(t, 100, time.Now)
+	render.TerminalSize = func(_ int) (int, int, error) { return 0, 0, errors.New("inappropriate ioctl for device") }
+	stderr := new(bytes.Buffer)
+	code := run([]string{"replay", "-speed", "0"}, strings.NewReader(testRecording), nil, stderr)
+
+	assert.Equal(t, exitSuccess, code, fmt.Sprintf("Exit code incorrect expected: %v; got: %v", exitSuccess, code))
+	assert.Contains(t, stderr.String(), "2.0/2.0 100.0%", fmt.Sprintf("Replay not finished: %q", stderr.String()))
+	assert.NotContains(t, stderr.String(), "inappropriate ioctl", fmt.Sprintf("Terminal error reported: %q", stderr.String()))
+}
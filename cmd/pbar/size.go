@@ -0,0 +1,73 @@
+/*
+ * The MIT License
+ *
+ * Copyright 2018 kinsey40.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * File:   size.go
+ * Author: kinsey40
+ *
+ * Created on 19 October 2026, 19:30
+ *
+ * Size parses sizes given on the command line, such as 1G or 512KiB.
+ *
+ */
+
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// sizeMultiples are the multiples of the size suffixes,
+// which are powers of 1024 (as with pv and dd).
+var sizeMultiples = map[string]float64{
+	"":  1.0,
+	"K": 1 << 10,
+	"M": 1 << 20,
+	"G": 1 << 30,
+	"T": 1 << 40,
+	"P": 1 << 50,
+}
+
+// parseSize parses a positive size, which may have a suffix
+// (K, M, G, T or P, optionally followed by B or iB).
+func parseSize(s string) (float64, error) {
+	value := strings.ToUpper(strings.TrimSpace(s))
+	value = strings.TrimSuffix(value, "B")
+	value = strings.TrimSuffix(value, "I")
+
+	suffix := ""
+	if len(value) > 0 {
+		if _, ok := sizeMultiples[value[len(value)-1:]]; ok {
+			suffix = value[len(value)-1:]
+			value = value[:len(value)-1]
+		}
+	}
+
+	number, err := strconv.ParseFloat(value, 64)
+	if err != nil || number <= 0.0 {
+		return 0.0, fmt.Errorf("Size: %q is invalid!", s)
+	}
+
+	return number * sizeMultiples[suffix], nil
+}
@@ -0,0 +1,71 @@
+/*
+ * The MIT License
+ *
+ * Copyright 2018 kinsey40.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * File:   size_test.go
+ * Author: kinsey40
+ *
+ * Created on 19 October 2026, 19:30
+ *
+ * The test file for size.go
+ *
+ */
+
+package main
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSize(t *testing.T) {
+	testCases := []struct {
+		size         string
+		expectedSize float64
+		expectError  bool
+	}{
+		{"100", 100.0, false},
+		{"1.5K", 1536.0, false},
+		{"1k", 1024.0, false},
+		{"2M", 2.0 * 1024.0 * 1024.0, false},
+		{"1G", 1024.0 * 1024.0 * 1024.0, false},
+		{"1GiB", 1024.0 * 1024.0 * 1024.0, false},
+		{"1GB", 1024.0 * 1024.0 * 1024.0, false},
+		{"10B", 10.0, false},
+		{"", 0.0, true},
+		{"0", 0.0, true},
+		{"-1K", 0.0, true},
+		{"Hello", 0.0, true},
+	}
+
+	for _, testCase := range testCases {
+		size, err := parseSize(testCase.size)
+		if testCase.expectError {
+			assert.Error(t, err, fmt.Sprintf("Expected error was not raised for: %q", testCase.size))
+		} else {
+			assert.NoError(t, err, fmt.Sprintf("Unexpected error(%v) was raised for: %q", err, testCase.size))
+			assert.Equal(t, testCase.expectedSize, size, fmt.Sprintf("Size incorrect expected: %v; got: %v", testCase.expectedSize, size))
+		}
+	}
+}
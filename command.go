@@ -0,0 +1,255 @@
+/*
+ * The MIT License
+ *
+ * Copyright 2018 kinsey40.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * File:   command.go
+ * Author: kinsey40
+ *
+ * Created on 19 October 2026, 20:10
+ *
+ * Command runs a command, displaying the progress it prints (e.g. "12/100"
+ * or "45%") as a progress bar. The rest of the output of the command is
+ * printed above the progress bar.
+ *
+ */
+
+package pbar
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os/exec"
+	"regexp"
+	"strconv"
+	"sync"
+)
+
+// DefaultProgressPatterns match the progress printed as a count (e.g.
+// "12/100" or "12 of 100") or as a percentage (e.g. "45%").
+var DefaultProgressPatterns = []string{
+	`(?P<current>\d+(?:\.\d+)?)\s*(?:/|of)\s*(?P<total>\d+(?:\.\d+)?)`,
+	`(?P<percent>\d+(?:\.\d+)?)\s*%`,
+}
+
+// Command runs a command, matching each line of its output (stdout and
+// stderr) against the progress patterns. A matching line moves the
+// progress bar, any other line is printed above the progress bar. The
+// progress bar can be altered using the Set*() functions before Run.
+type Command struct {
+	*Iterator
+
+	Cmd      *exec.Cmd
+	patterns []*regexp.Regexp
+}
+
+// NewCommand creates a Command which runs the cmd, matching the
+// progress using the DefaultProgressPatterns.
+func NewCommand(cmd *exec.Cmd) *Command {
+	c := &Command{Iterator: makeIteratorObject().(*Iterator), Cmd: cmd}
+	c.createIteratorFromValues(100.0)
+	c.SetProgressPatterns(DefaultProgressPatterns...)
+
+	return c
+}
+
+// SetProgressPatterns sets the regular expressions used to find the
+// progress in the output of the command, the first matching pattern is
+// used. Each pattern must contain either the named groups "current" (and
+// optionally "total") or "percent", e.g. `(?P<percent>\d+)%`.
+//
+// Default Value: DefaultProgressPatterns
+func (c *Command) SetProgressPatterns(patterns ...string) error {
+	compiled := make([]*regexp.Regexp, 0, len(patterns))
+	for _, pattern := range patterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return err
+		}
+
+		if subexpIndex(re, "current") < 0 && subexpIndex(re, "percent") < 0 {
+			return fmt.Errorf("Pattern: %q has no current or percent group!", pattern)
+		}
+
+		compiled = append(compiled, re)
+	}
+
+	if len(compiled) == 0 {
+		return errors.New("At least one pattern is required!")
+	}
+
+	c.patterns = compiled
+
+	return nil
+}
+
+// Run starts the command and waits for it to finish. The progress bar is
+// completed if the command succeeds, otherwise the progress bar is aborted
+// and the error of the command (e.g. an *exec.ExitError) is returned.
+func (c *Command) Run() error {
+	stdout, err := c.Cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+
+	stderr, err := c.Cmd.StderrPipe()
+	if err != nil {
+		return err
+	}
+
+	c.Initialize()
+	if err := c.Cmd.Start(); err != nil {
+		c.Abort(err)
+		return err
+	}
+
+	var wg sync.WaitGroup
+	for _, output := range []io.Reader{stdout, stderr} {
+		wg.Add(1)
+		go func(output io.Reader) {
+			defer wg.Done()
+			c.scan(output)
+		}(output)
+	}
+
+	wg.Wait()
+	if err := c.Cmd.Wait(); err != nil {
+		c.Abort(err)
+		return err
+	}
+
+	mutex := c.lock()
+	defer c.unlock(mutex)
+
+	if c.state != Running {
+		return c.err
+	}
+
+	c.Clock.Now()
+
+	return c.moveTo(c.Values.GetStop())
+}
+
+// scan reads the output of the command line by line, a line ends
+// with either a newline or a carriage return.
+func (c *Command) scan(output io.Reader) {
+	scanner := bufio.NewScanner(output)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	scanner.Split(scanLines)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if current, total, ok := c.match(line); ok {
+			c.progressTo(current, total)
+		} else if line != "" {
+			c.Println(line)
+		}
+	}
+
+	// The rest of the output is discarded, so the command is not blocked
+	io.Copy(ioutil.Discard, output)
+}
+
+// match finds the progress within the line, using the first matching
+// pattern. The total is zero if the pattern does not contain one.
+func (c *Command) match(line string) (float64, float64, bool) {
+	for _, re := range c.patterns {
+		match := re.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+
+		if index := subexpIndex(re, "percent"); index >= 0 && match[index] != "" {
+			percent, err := strconv.ParseFloat(match[index], 64)
+			return percent, 100.0, err == nil
+		}
+
+		current, err := strconv.ParseFloat(match[subexpIndex(re, "current")], 64)
+		if err != nil {
+			continue
+		}
+
+		total := 0.0
+		if index := subexpIndex(re, "total"); index >= 0 && match[index] != "" {
+			total, _ = strconv.ParseFloat(match[index], 64)
+		}
+
+		return current, total, true
+	}
+
+	return 0.0, 0.0, false
+}
+
+// progressTo moves the progress bar to the current value, first setting
+// the total if it is greater than zero.
+func (c *Command) progressTo(current, total float64) error {
+	mutex := c.lock()
+	defer c.unlock(mutex)
+
+	if c.state != Running || c.Clock.IsStartTimeSet() != nil {
+		return c.err
+	}
+
+	if total > 0.0 {
+		c.Values.SetStop(total)
+	}
+
+	if current < c.Values.GetStart() {
+		current = c.Values.GetStart()
+	}
+
+	c.Clock.Now()
+
+	return c.moveTo(current)
+}
+
+// subexpIndex returns the index of the named group
+// within the pattern, or -1 if there is no such group.
+func subexpIndex(re *regexp.Regexp, name string) int {
+	for index, subexp := range re.SubexpNames() {
+		if subexp == name {
+			return index
+		}
+	}
+
+	return -1
+}
+
+// scanLines is a bufio.SplitFunc splitting the output at
+// newlines and carriage returns, removing either.
+func scanLines(data []byte, atEOF bool) (int, []byte, error) {
+	if atEOF && len(data) == 0 {
+		return 0, nil, nil
+	}
+
+	if index := bytes.IndexAny(data, "\r\n"); index >= 0 {
+		return index + 1, data[:index], nil
+	}
+
+	if atEOF {
+		return len(data), data, nil
+	}
+
+	return 0, nil, nil
+}
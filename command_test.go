@@ -0,0 +1,146 @@
+/*
+ * The MIT License
+ *
+ * Copyright 2018 kinsey40.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * File:   command_test.go
+ * Author: kinsey40
+ *
+ * Created on 19 October 2026, 20:10
+ *
+ * The test file for command.go
+ *
+ */
+
+package pbar_test
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/kinsey40/pbar"
+	"github.com/kinsey40/pbar/internal/pbartest"
+	"github.com/kinsey40/pbar/render"
+	"github.com/stretchr/testify/assert"
+)
+
+// helperCommand creates a command which runs TestCommandHelperProcess, it
+// writes the lines (to stderr if prefixed with "err:") and exits with the code.
+func helperCommand(code int, lines ...string) *exec.Cmd {
+	args := append([]string{"-test.run=TestCommandHelperProcess", "--", strconv.Itoa(code)}, lines...)
+	cmd := exec.Command(os.Args[0], args...)
+	cmd.Env = append(os.Environ(), "PBAR_HELPER_PROCESS=1")
+
+	return cmd
+}
+
+func TestCommandHelperProcess(t *testing.T) {
+	if os.Getenv("PBAR_HELPER_PROCESS") != "1" {
+		return
+	}
+
+	args := os.Args
+	for len(args) > 0 && args[0] != "--" {
+		args = args[1:]
+	}
+
+	code, _ := strconv.Atoi(args[1])
+	for _, line := range args[2:] {
+		if strings.HasPrefix(line, "err:") {
+			fmt.Fprint(os.Stderr, strings.TrimPrefix(line, "err:"))
+		} else {
+			fmt.Fprint(os.Stdout, line)
+		}
+	}
+
+	os.Exit(code)
+}
+
+func TestCommand(t *testing.T) {
+	testCases := []struct {
+		code            int
+		lines           []string
+		expectError     bool
+		expectedPrinted []string
+		expectedFinal   string
+	}{
+		{0, []string{"Building\n", "1/4\n", "2 of 4\n", "Done\n"}, false, []string{"Building\n", "Done\n"}, "4.0/4.0 100.0%"},
+		{0, []string{"10%\r", "55.5%\r", "err:Warning\n"}, false, []string{"Warning\n"}, "100.0/100.0 100.0%"},
+		{3, []string{"1/4\n", "err:Failed\n"}, true, []string{"Failed\n"}, "1.0/4.0 25.0%"},
+	}
+
+	for _, testCase := range testCases {
+		pbartest.Stub(t, 100, pbartest.At(0))
+
+		buffer := new(bytes.Buffer)
+		c := pbar.NewCommand(helperCommand(testCase.code, testCase.lines...))
+		c.Write = &render.Writing{W: buffer}
+		err := c.Run()
+		got := buffer.String()
+
+		if testCase.expectError {
+			exitErr, ok := err.(*exec.ExitError)
+			assert.True(t, ok, fmt.Sprintf("Exit error not returned: %v", err))
+			if ok {
+				assert.False(t, exitErr.Success(), fmt.Sprintf("Command succeeded: %v", exitErr))
+			}
+			assert.Equal(t, pbar.Aborted, c.Snapshot().State, fmt.Sprintf("Progress bar not aborted"))
+		} else {
+			assert.NoError(t, err, fmt.Sprintf("Unexpected error raised: %v", err))
+			assert.Equal(t, pbar.Finished, c.Snapshot().State, fmt.Sprintf("Progress bar not finished"))
+		}
+
+		for _, printed := range testCase.expectedPrinted {
+			assert.Contains(t, got, printed, fmt.Sprintf("Line (%q) not printed: %q", printed, got))
+		}
+
+		assert.NotContains(t, got, "1/4", fmt.Sprintf("Progress line printed: %q", got))
+		assert.Contains(t, got, testCase.expectedFinal, fmt.Sprintf("Final progress (%v) missing: %q", testCase.expectedFinal, got))
+	}
+}
+
+func TestSetProgressPatterns(t *testing.T) {
+	testCases := []struct {
+		patterns    []string
+		expectError bool
+	}{
+		{[]string{`step (?P<current>\d+)`}, false},
+		{[]string{`(?P<percent>\d+) percent`, `(?P<current>\d+)/(?P<total>\d+)`}, false},
+		{[]string{`(\d+)/(\d+)`}, true},
+		{[]string{`(?P<current>\d+`}, true},
+		{[]string{}, true},
+	}
+
+	for _, testCase := range testCases {
+		c := pbar.NewCommand(exec.Command("true"))
+		err := c.SetProgressPatterns(testCase.patterns...)
+		if testCase.expectError {
+			assert.Error(t, err, fmt.Sprintf("Expected error not raised for: %q", testCase.patterns))
+		} else {
+			assert.NoError(t, err, fmt.Sprintf("Unexpected error raised: %v", err))
+		}
+	}
+}
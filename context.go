@@ -0,0 +1,286 @@
+/*
+ * The MIT License
+ *
+ * Copyright 2018 kinsey40.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * File:   context.go
+ * Author: kinsey40
+ *
+ * Created on 19 October 2026, 09:40
+ *
+ * Context enables a progress bar to be tied to a context.Context. When the
+ * context is cancelled the progress bar is aborted, rendering a final
+ * "cancelled" frame and releasing its lines within a tree of progress bars.
+ *
+ */
+
+package pbar
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/kinsey40/pbar/render"
+)
+
+// State describes whether a progress bar is running, has
+// finished or has been aborted.
+type State int
+
+// The states of a progress bar, a progress bar is Running until it
+// reaches its stop value (Finished) or is aborted (Aborted).
+const (
+	Running State = iota
+	Finished
+	Aborted
+)
+
+// AbortedMessage is displayed in place of the time remaining
+// when a progress bar is aborted.
+var AbortedMessage = "cancelled"
+
+// String returns the name of the state
+func (s State) String() string {
+	switch s {
+	case Running:
+		return "running"
+	case Finished:
+		return "finished"
+	case Aborted:
+		return "aborted"
+	}
+
+	return fmt.Sprintf("State(%d)", int(s))
+}
+
+// parseState returns the state with the name
+func parseState(name string) (State, error) {
+	for _, state := range []State{Running, Finished, Aborted} {
+		if state.String() == name {
+			return state, nil
+		}
+	}
+
+	return Running, fmt.Errorf("State: %q is not recognised!", name)
+}
+
+// NewWithContext creates a progress bar from the inputted values or
+// object, as with Pbar, which is aborted when the context is cancelled.
+func NewWithContext(ctx context.Context, values ...interface{}) (Iterate, error) {
+	itr, err := Pbar(values...)
+	if err != nil {
+		return nil, err
+	}
+
+	itr.WithContext(ctx)
+
+	return itr, nil
+}
+
+// WithContext ties the progress bar to the context, if the context is
+// cancelled the progress bar is aborted. It should be called before
+// Initialize.
+//
+// Default Value: nil (the progress bar is never cancelled)
+func (itr *Iterator) WithContext(ctx context.Context) {
+	itr.ctx = ctx
+}
+
+// Next moves the progress bar forward and reports whether there is
+// another iteration to perform. The first call Initializes the progress
+// bar, so the bar can be driven from the condition of the for-loop:
+//
+//	for p.Next() {
+//		// Do something...
+//	}
+//
+// Next returns false once the progress bar finishes, is aborted or
+// the context is cancelled, the reason can be found using Err.
+func (itr *Iterator) Next() bool {
+	return itr.next(itr.Initialize, itr.Update)
+}
+
+// next performs either the initialize or the update function, depending on
+// whether the progress bar has started, and reports whether the progress bar
+// is still running.
+func (itr *Iterator) next(initialize, update func() error) bool {
+	if itr.ctx != nil && itr.ctx.Err() != nil {
+		itr.Abort(itr.ctx.Err())
+		return false
+	}
+
+	var err error
+	if itr.Clock.IsStartTimeSet() != nil {
+		err = initialize()
+	} else {
+		err = update()
+	}
+
+	mutex := itr.lock()
+	defer mutex.Unlock()
+
+	if err != nil && itr.err == nil {
+		itr.err = err
+	}
+
+	return err == nil && itr.state == Running
+}
+
+// Err returns the error which caused the progress bar to stop,
+// this is nil if the progress bar finished normally.
+func (itr *Iterator) Err() error {
+	mutex := itr.lock()
+	defer mutex.Unlock()
+
+	return itr.err
+}
+
+// Abort stops the progress bar, rendering a final "cancelled" frame.
+// The children of the progress bar are also aborted, and an aborted
+// child is removed from the tree. Updates after an Abort return err.
+func (itr *Iterator) Abort(err error) error {
+	mutex := itr.lock()
+	defer itr.unlock(mutex)
+
+	return itr.abort(err)
+}
+
+// abort marks the progress bar, and its children, as aborted and
+// renders the final frame. The lock must be held by the caller.
+func (itr *Iterator) abort(err error) error {
+	if itr.state != Running {
+		return nil
+	}
+
+	if err == nil {
+		err = context.Canceled
+	}
+
+	itr.abortTree(err)
+	if itr.Clock.IsStartTimeSet() != nil {
+		itr.notify()
+		return nil
+	}
+
+	itr.Clock.Now()
+	itr.line = itr.formatAbortedBar()
+	itr.notify()
+	if itr.jsonOutput != nil {
+		return itr.writeJSON()
+	}
+
+	if itr.inTree() {
+		return itr.refreshTree()
+	}
+
+	if err := itr.render(itr.line); err != nil {
+		return err
+	}
+
+	return itr.renderSuffix()
+}
+
+// abortTree sets the state of the progress bar and its running
+// children to Aborted.
+func (itr *Iterator) abortTree(err error) {
+	itr.err = err
+	itr.setState(Aborted)
+	for _, child := range itr.children {
+		if child.state == Running {
+			child.abortTree(err)
+			child.notify()
+		}
+	}
+}
+
+// formatAbortedBar creates the final frame of an aborted progress bar,
+// displaying the last completed iteration in the error colour.
+func (itr *Iterator) formatAbortedBar() string {
+	current := itr.shown
+	if current < itr.Values.GetStart() {
+		current = itr.Values.GetStart()
+	}
+
+	itr.Values.SetCurrent(current)
+	itr.Settings.SetStatus(render.StatusError)
+	statistics, numStepsCompleted := itr.Values.Statistics(itr.Settings.GetLineSize())
+	itr.Settings.SetPercentage(itr.Values.Percentage())
+	speedMeter := fmt.Sprintf("[%s, elapsed: %s]", AbortedMessage, itr.Clock.Format(itr.Clock.Subtract()))
+
+	return fmt.Sprintf("%s %s %s",
+		itr.Settings.CreateBarString(numStepsCompleted),
+		itr.Settings.Paint(render.StatisticsSegment, statistics),
+		itr.Settings.Paint(render.ETASegment, speedMeter),
+	)
+}
+
+// setState sets the state of the progress bar, signalling any watching
+// goroutine and stopping it within its Registry once the progress bar
+// stops running.
+func (itr *Iterator) setState(state State) {
+	if itr.state == state {
+		return
+	}
+
+	itr.state = state
+	if state == Running {
+		return
+	}
+
+	if itr.done != nil {
+		close(itr.done)
+		itr.done = nil
+	}
+
+	if itr.registry != nil {
+		itr.registry.stop(itr)
+	}
+}
+
+// watch aborts the progress bar in the background when the context is
+// cancelled, stopping once the progress bar is no longer running.
+func (itr *Iterator) watch() {
+	mutex := itr.lock()
+	ctx, done := itr.ctx, itr.done
+	mutex.Unlock()
+
+	if ctx == nil || done == nil {
+		return
+	}
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			itr.Abort(ctx.Err())
+		case <-done:
+		}
+	}()
+}
+
+// lock locks, and returns, the mutex shared by the whole tree of
+// progress bars. This serialises the rendering of the tree.
+func (itr *Iterator) lock() *sync.Mutex {
+	mutex := &itr.root().mutex
+	mutex.Lock()
+
+	return mutex
+}
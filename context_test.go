@@ -0,0 +1,203 @@
+/*
+ * The MIT License
+ *
+ * Copyright 2018 kinsey40.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * File:   context_test.go
+ * Author: kinsey40
+ *
+ * Created on 19 October 2026, 11:15
+ *
+ * The test file for context.go
+ *
+ */
+
+package pbar_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kinsey40/pbar"
+	"github.com/kinsey40/pbar/internal/pbartest"
+	"github.com/kinsey40/pbar/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func createContextBar(t *testing.T, ctx context.Context, buffer *bytes.Buffer, values ...interface{}) *pbar.Iterator {
+	pbartest.Stub(t, 100, pbartest.At(0))
+
+	p, err := pbar.NewWithContext(ctx, values...)
+	assert.NoError(t, err, fmt.Sprintf("Unexpected error(%v) was raised!", err))
+
+	itr := p.(*pbar.Iterator)
+	itr.Write = &render.Writing{W: buffer}
+
+	return itr
+}
+
+func TestState(t *testing.T) {
+	testCases := []struct {
+		state    pbar.State
+		expected string
+	}{
+		{pbar.Running, "running"},
+		{pbar.Finished, "finished"},
+		{pbar.Aborted, "aborted"},
+		{pbar.State(7), "State(7)"},
+	}
+
+	for _, testCase := range testCases {
+		got := testCase.state.String()
+		assert.Equal(t, testCase.expected, got, fmt.Sprintf("State string expected: %v; got: %v", testCase.expected, got))
+	}
+}
+
+func TestNewWithContext(t *testing.T) {
+	testCases := []struct {
+		values      []interface{}
+		expectError bool
+	}{
+		{[]interface{}{3}, false},
+		{[]interface{}{[]int{1, 2}}, false},
+		{[]interface{}{complex128(1)}, true},
+	}
+
+	for _, testCase := range testCases {
+		p, err := pbar.NewWithContext(context.Background(), testCase.values...)
+		if testCase.expectError {
+			assert.Error(t, err, fmt.Sprintf("Expected error was not raised!"))
+			assert.Nil(t, p, fmt.Sprintf("Iterator is not nil (%v)", p))
+		} else {
+			assert.NoError(t, err, fmt.Sprintf("Unexpected error(%v) was raised!", err))
+		}
+	}
+}
+
+func TestNext(t *testing.T) {
+	buffer := new(bytes.Buffer)
+	itr := createContextBar(t, context.Background(), buffer, 3)
+
+	iterations := 0
+	for itr.Next() {
+		iterations++
+	}
+
+	got := buffer.String()
+	assert.Equal(t, 3, iterations, fmt.Sprintf("Iterations expected: %v; got: %v", 3, iterations))
+	assert.NoError(t, itr.Err(), fmt.Sprintf("Unexpected error(%v) was raised!", itr.Err()))
+	assert.Contains(t, got, "3.0/3.0 100.0%", fmt.Sprintf("Final frame not rendered: %q", got))
+	assert.True(t, strings.HasSuffix(got, "\n"), fmt.Sprintf("Suffix not written at the end: %q", got))
+	assert.False(t, itr.Next(), fmt.Sprintf("Next returned true once finished"))
+}
+
+func TestNextCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	buffer := new(bytes.Buffer)
+	itr := createContextBar(t, ctx, buffer, 10)
+
+	iterations := 0
+	for itr.Next() {
+		iterations++
+		if iterations == 4 {
+			cancel()
+			assert.Eventually(t, func() bool { return itr.Err() != nil }, time.Second, time.Millisecond)
+		}
+	}
+
+	got := buffer.String()
+	assert.Equal(t, 4, iterations, fmt.Sprintf("Iterations expected: %v; got: %v", 4, iterations))
+	assert.Equal(t, context.Canceled, itr.Err(), fmt.Sprintf("Error expected: %v; got: %v", context.Canceled, itr.Err()))
+	assert.Contains(t, got, "3.0/10.0 30.0% [cancelled, elapsed: 00m:00s]", fmt.Sprintf("Cancelled frame not rendered: %q", got))
+	assert.Equal(t, 1, strings.Count(got, "cancelled"), fmt.Sprintf("Cancelled frame rendered more than once: %q", got))
+	assert.Equal(t, context.Canceled, itr.Update(), fmt.Sprintf("Update after cancel did not return the error"))
+}
+
+func TestAbort(t *testing.T) {
+	abortErr := errors.New("Failed!")
+	testCases := []struct {
+		initialize    bool
+		err           error
+		expectedErr   error
+		expectedFrame string
+	}{
+		{true, abortErr, abortErr, "\r|#-   | 2.0/5.0 40.0% [cancelled, elapsed: 00m:00s]\r\n"},
+		{true, nil, context.Canceled, "\r|#-   | 2.0/5.0 40.0% [cancelled, elapsed: 00m:00s]\r\n"},
+		{false, abortErr, abortErr, ""},
+	}
+
+	for _, testCase := range testCases {
+		buffer := new(bytes.Buffer)
+		itr := createContextBar(t, context.Background(), buffer, 5)
+		itr.SetPreset("ascii")
+		itr.SetLParen("|")
+		itr.SetFinishedIterationSymbol("#")
+		itr.SetCurrentIterationSymbol("-")
+		if testCase.initialize {
+			itr.Initialize()
+			itr.Settings.SetLineSize(5)
+			itr.Update()
+			itr.Update()
+		}
+
+		buffer.Reset()
+		assert.NoError(t, itr.Abort(testCase.err), fmt.Sprintf("Unexpected error raised by Abort"))
+		assert.NoError(t, itr.Abort(abortErr), fmt.Sprintf("Unexpected error raised by second Abort"))
+		assert.Equal(t, testCase.expectedErr, itr.Err(), fmt.Sprintf("Error expected: %v; got: %v", testCase.expectedErr, itr.Err()))
+		assert.Equal(t, testCase.expectedFrame, buffer.String(), fmt.Sprintf("Frame expected: %q; got: %q", testCase.expectedFrame, buffer.String()))
+	}
+}
+
+func TestAbortTree(t *testing.T) {
+	buffer := new(bytes.Buffer)
+	parent := createContextBar(t, context.Background(), buffer, 2)
+	parent.SetDescription("Parent")
+	parent.Initialize()
+
+	c, _ := parent.Child(4, "Child")
+	child := c.(*pbar.Iterator)
+	child.Initialize()
+	child.Update()
+	child.Abort(context.Canceled)
+
+	frames := strings.Split(buffer.String(), "\033[J")
+	lastFrame := frames[len(frames)-2]
+	assert.NotContains(t, lastFrame, "Child:", fmt.Sprintf("Aborted child still displayed: %q", lastFrame))
+	assert.NoError(t, parent.Err(), fmt.Sprintf("Parent aborted with its child"))
+
+	other, _ := parent.Child(4, "Other")
+	other.Initialize()
+	parent.Abort(context.Canceled)
+
+	got := buffer.String()
+	frames = strings.Split(got, "\033[J")
+	lastFrame = frames[len(frames)-2]
+	assert.Equal(t, context.Canceled, other.Err(), fmt.Sprintf("Child not aborted with its parent"))
+	assert.Contains(t, lastFrame, "Parent:", fmt.Sprintf("Parent missing from final frame: %q", lastFrame))
+	assert.Contains(t, lastFrame, "cancelled", fmt.Sprintf("Parent not cancelled in final frame: %q", lastFrame))
+	assert.True(t, strings.HasSuffix(got, "\033[J\r\n"), fmt.Sprintf("Suffix not written at the end: %q", got))
+}
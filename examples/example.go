@@ -40,7 +40,9 @@
 package main
 
 import (
+	"context"
 	"fmt"
+	"log"
 	"sync"
 	"time"
 
@@ -178,6 +180,130 @@ func multipleProgressBars() {
 	p.MultiEnd()
 }
 
+// Create a tree of progress bars, the progress of the parent is derived
+// from the progress of its children
+func hierarchicalProgressBars() {
+	files := []string{"a.txt", "b.txt", "c.txt"}
+	p, err := pbar.Pbar(files)
+	if err != nil {
+		panic(err)
+	}
+
+	p.SetDescription("Files")
+	p.SetAggregate(true)
+	p.SetFinishedChildren(pbar.CollapseFinished)
+	p.Initialize()
+	for _, file := range files {
+		c, _ := p.Child(5, file)
+		c.Initialize()
+		for i := 0; i < 5; i++ {
+			time.Sleep(time.Millisecond * 200)
+			c.Update()
+		}
+	}
+}
+
+// Create a staged progress bar, where each stage has its own total and weight
+func stagedProgressBar() {
+	s, err := pbar.Stages(
+		pbar.Stage{Name: "download", Total: 3, Weight: 1},
+		pbar.Stage{Name: "parse", Total: 5, Weight: 2},
+		pbar.Stage{Name: "load", Total: 2, Weight: 1},
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	s.SetDescription("Stages")
+	s.Initialize()
+	for i := 0; i < 10; i++ {
+		time.Sleep(time.Millisecond * 300)
+		s.Update()
+	}
+}
+
+// Create a Pbar object which is cancelled part way through by its context
+func cancelledProgressBar() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*1200)
+	defer cancel()
+
+	p, err := pbar.NewWithContext(ctx, 10)
+	if err != nil {
+		panic(err)
+	}
+
+	p.SetDescription("Cancelled")
+	for p.Next() {
+		time.Sleep(time.Millisecond * 300)
+	}
+}
+
+// Process a slice using a pool of workers, which share a single progress bar
+func parallelWork() {
+	x := make([]int, 20)
+	p := pbar.NewPool(4)
+	p.SetDescription("Parallel")
+	p.SetWorkerLines(true)
+	err := p.ForEach(context.Background(), x, func(_ context.Context, _ interface{}) error {
+		time.Sleep(time.Millisecond * 300)
+		return nil
+	})
+	if err != nil {
+		panic(err)
+	}
+}
+
+// Launch goroutines in a Group, the total grows as each is launched
+func groupOfGoroutines() {
+	g := pbar.NewGroup()
+	g.SetDescription("Group")
+	for i := 0; i < 10; i++ {
+		delay := time.Millisecond * time.Duration(200*(i+1))
+		g.Go(func() error {
+			time.Sleep(delay)
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		panic(err)
+	}
+}
+
+// Print log lines above a progress bar, without leaving fragments of the bar
+func printAboveProgressBar() {
+	x := []int{1, 2, 3, 4, 5}
+	p, err := pbar.Pbar(x)
+	if err != nil {
+		panic(err)
+	}
+
+	p.SetDescription("Logging")
+	logger := log.New(p.Writer(), "example: ", log.LstdFlags)
+	p.Initialize()
+	for i := range x {
+		time.Sleep(time.Millisecond * 500)
+		logger.Printf("Processed item %d", i)
+		p.Update()
+	}
+}
+
+// Create a Spinner for work of an unknown size
+func spinner() {
+	s, err := pbar.NewSpinner("braille")
+	if err != nil {
+		panic(err)
+	}
+
+	s.SetDescription("Spinner")
+	s.SetMessage("Connecting")
+	s.Start()
+	time.Sleep(time.Millisecond * 1500)
+	s.SetMessage("Downloading")
+	time.Sleep(time.Millisecond * 1500)
+	s.Success("Complete")
+}
+
 // Threaded bars currently does not work correctly. See the Issues board.
 func threadedBars() {
 	var wg sync.WaitGroup
@@ -218,5 +344,26 @@ func main() {
 
 	fmt.Println("\nUsing Multiple Progress Bars:")
 	multipleProgressBars()
+
+	fmt.Println("\nUsing Nested Progress Bars:")
+	hierarchicalProgressBars()
+
+	fmt.Println("\nUsing a Staged Progress Bar:")
+	stagedProgressBar()
+
+	fmt.Println("\nUsing a Cancelled Progress Bar:")
+	cancelledProgressBar()
+
+	fmt.Println("\nUsing a Pool of Workers:")
+	parallelWork()
+
+	fmt.Println("\nUsing a Group of Goroutines:")
+	groupOfGoroutines()
+
+	fmt.Println("\nPrinting above a Progress Bar:")
+	printAboveProgressBar()
+
+	fmt.Println("\nUsing a Spinner:")
+	spinner()
 	// threadedBars()
 }
@@ -0,0 +1,79 @@
+/*
+ * The MIT License
+ *
+ * Copyright 2018 kinsey40.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * File:   expvar.go
+ * Author: kinsey40
+ *
+ * Created on 19 October 2026, 17:30
+ *
+ * Package expvar publishes the progress of the running progress bars using
+ * the standard library expvar package, making the progress visible under
+ * /debug/vars without any additional dependencies.
+ *
+ */
+
+package expvar
+
+import (
+	"errors"
+	stdexpvar "expvar"
+	"fmt"
+
+	"github.com/kinsey40/pbar"
+)
+
+// Name is the name the pbar.DefaultRegistry is published under by PublishDefault
+var Name = "pbar"
+
+// Publish publishes the progress bars in the Registry under the name, a nil
+// Registry means the pbar.DefaultRegistry. Each progress bar is listed by
+// its name within the Registry, with the fields of the JSON output:
+//
+//	"pbar": {"Files": {"desc":"Files","current":3,"total":10,"percent":30,...}}
+//
+// A progress bar is listed from when it is Initialized until the retention
+// of the Registry has passed since it finished or was aborted.
+func Publish(name string, registry *pbar.Registry) error {
+	if name == "" {
+		return errors.New("Name cannot be empty!")
+	}
+
+	if stdexpvar.Get(name) != nil {
+		return fmt.Errorf("Variable (%v) has already been published!", name)
+	}
+
+	if registry == nil {
+		registry = pbar.DefaultRegistry
+	}
+
+	stdexpvar.Publish(name, stdexpvar.Func(func() interface{} {
+		return registry.Snapshots()
+	}))
+
+	return nil
+}
+
+// PublishDefault publishes the pbar.DefaultRegistry under Name
+func PublishDefault() error {
+	return Publish(Name, nil)
+}
@@ -0,0 +1,124 @@
+/*
+ * The MIT License
+ *
+ * Copyright 2018 kinsey40.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * File:   expvar_test.go
+ * Author: kinsey40
+ *
+ * Created on 19 October 2026, 17:30
+ *
+ * The test file for expvar.go
+ *
+ */
+
+package expvar_test
+
+import (
+	"bytes"
+	"encoding/json"
+	stdexpvar "expvar"
+	"fmt"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/kinsey40/pbar"
+	"github.com/kinsey40/pbar/expvar"
+	"github.com/kinsey40/pbar/internal/pbartest"
+	"github.com/stretchr/testify/assert"
+)
+
+func createBar(t *testing.T, registry *pbar.Registry, description string, stop int) *pbar.Iterator {
+	itr := pbartest.NewBar(t, new(bytes.Buffer), pbartest.Ticking(), stop)
+	itr.SetDescription(description)
+	itr.SetRegistry(registry)
+
+	return itr
+}
+
+func uniqueName(prefix string) string {
+	return fmt.Sprintf("%v_%d", prefix, time.Now().UnixNano())
+}
+
+func TestPublish(t *testing.T) {
+	name := uniqueName("test_bars")
+	testCases := []struct {
+		name        string
+		expectError bool
+	}{
+		{"", true},
+		{name, false},
+		{name, true},
+	}
+
+	for _, testCase := range testCases {
+		err := expvar.Publish(testCase.name, pbar.NewRegistry())
+		if testCase.expectError {
+			assert.Error(t, err, fmt.Sprintf("Expected error was not raised for: %q", testCase.name))
+		} else {
+			assert.NoError(t, err, fmt.Sprintf("Unexpected error(%v) was raised!", err))
+		}
+	}
+}
+
+func TestPublishedBars(t *testing.T) {
+	name := uniqueName("test_published_bars")
+	registry := pbar.NewRegistry()
+	err := expvar.Publish(name, registry)
+	assert.NoError(t, err, fmt.Sprintf("Unexpected error(%v) was raised!", err))
+
+	itr := createBar(t, registry, "Work", 2)
+	itr.Initialize()
+	itr.Update()
+
+	bars := readVars(t)[name]
+	work := bars["Work"]
+	assert.Equal(t, "Work", work["desc"], fmt.Sprintf("Description incorrect: %v", work))
+	assert.Equal(t, 1.0, work["current"], fmt.Sprintf("Current incorrect: %v", work))
+	assert.Equal(t, 2.0, work["total"], fmt.Sprintf("Total incorrect: %v", work))
+	assert.Equal(t, 50.0, work["percent"], fmt.Sprintf("Percent incorrect: %v", work))
+	assert.Equal(t, 0.5, work["rate"], fmt.Sprintf("Rate incorrect: %v", work))
+	assert.Equal(t, "running", work["state"], fmt.Sprintf("State incorrect: %v", work))
+
+	itr.Update()
+	work = readVars(t)[name]["Work"]
+	assert.Equal(t, "finished", work["state"], fmt.Sprintf("Finished state not published: %v", work))
+}
+
+func readVars(t *testing.T) map[string]map[string]map[string]interface{} {
+	recorder := httptest.NewRecorder()
+	stdexpvar.Handler().ServeHTTP(recorder, httptest.NewRequest("GET", "/debug/vars", nil))
+
+	var raw map[string]json.RawMessage
+	err := json.Unmarshal(recorder.Body.Bytes(), &raw)
+	assert.NoError(t, err, fmt.Sprintf("Unexpected error(%v) was raised!", err))
+
+	vars := make(map[string]map[string]map[string]interface{})
+	for name, value := range raw {
+		var bars map[string]map[string]interface{}
+		if json.Unmarshal(value, &bars) == nil {
+			vars[name] = bars
+		}
+	}
+
+	return vars
+}
@@ -0,0 +1,162 @@
+/*
+ * The MIT License
+ *
+ * Copyright 2018 kinsey40.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * File:   group.go
+ * Author: kinsey40
+ *
+ * Created on 19 October 2026, 10:05
+ *
+ * Group mirrors errgroup.Group, running functions in goroutines and waiting
+ * for them to complete, whilst displaying a progress bar of the completed and
+ * launched functions. The total of the progress bar grows as functions are
+ * launched.
+ *
+ */
+
+package pbar
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/kinsey40/pbar/render"
+)
+
+// Group runs functions in goroutines, displaying the number of completed
+// functions against the number launched, alongside the number which failed.
+// The progress bar can be altered using the Set*() functions before the
+// first call to Go.
+type Group struct {
+	*Iterator
+
+	wg       sync.WaitGroup
+	cancel   func()
+	firstErr error
+	failed   int
+	started  bool
+}
+
+// NewGroup creates a Group, the progress bar is displayed once the
+// first function is launched.
+func NewGroup() *Group {
+	return &Group{Iterator: makeIteratorObject().(*Iterator)}
+}
+
+// GroupWithContext creates a Group and a derived context, as with
+// errgroup.WithContext. The context is cancelled the first time a function
+// returns an error, or once Wait returns, so the other functions can stop.
+func GroupWithContext(ctx context.Context) (*Group, context.Context) {
+	ctx, cancel := context.WithCancel(ctx)
+	g := NewGroup()
+	g.cancel = cancel
+
+	return g, ctx
+}
+
+// Go launches the function in a new goroutine, increasing the total of
+// the progress bar by one. The progress bar moves forward once the
+// function returns, if it returns an error the failed count is increased.
+// Go must not be called after Wait.
+func (g *Group) Go(fn func() error) {
+	mutex := g.lock()
+	if !g.started {
+		g.started = true
+		if err := g.start(); err == nil {
+			g.Settings.SetLineSize(g.Settings.GetLineSize() - len(" [failed: 0000]"))
+		}
+		g.Values.SetStart(0.0)
+		g.Values.SetCurrent(0.0)
+		g.Values.SetStep(1.0)
+		g.Values.SetStop(0.0)
+	}
+
+	g.Values.SetStop(g.Values.GetStop() + g.Values.GetStep())
+	g.wg.Add(1)
+	g.draw()
+	g.unlock(mutex)
+
+	go func() {
+		defer g.wg.Done()
+		g.done(fn())
+	}()
+}
+
+// Wait blocks until all of the launched functions have returned, renders
+// the final progress bar and returns the first error (if any).
+func (g *Group) Wait() error {
+	g.wg.Wait()
+	if g.cancel != nil {
+		g.cancel()
+	}
+
+	mutex := g.lock()
+	defer g.unlock(mutex)
+
+	if g.started && g.state == Running {
+		g.setState(Finished)
+		g.draw()
+		g.renderSuffix()
+	}
+
+	return g.firstErr
+}
+
+// Failed returns the number of functions which have returned an error
+func (g *Group) Failed() int {
+	mutex := g.lock()
+	defer mutex.Unlock()
+
+	return g.failed
+}
+
+// done records the result of a function and moves the progress bar forward
+func (g *Group) done(err error) {
+	mutex := g.lock()
+	defer g.unlock(mutex)
+
+	if err != nil {
+		g.failed++
+		g.Settings.SetStatus(render.StatusError)
+		if g.firstErr == nil {
+			g.firstErr = err
+			if g.cancel != nil {
+				g.cancel()
+			}
+		}
+	}
+
+	g.Values.SetCurrent(g.Values.GetCurrent() + g.Values.GetStep())
+	g.draw()
+}
+
+// draw renders the progress bar, followed by the number of failed
+// functions. The lock must be held by the caller.
+func (g *Group) draw() error {
+	g.Clock.Now()
+	bar := g.formatProgressBar(g.Values.GetStart(), g.Values.GetStop(), g.Values.GetCurrent(), g.Settings.GetLineSize())
+	failed := g.Settings.Paint(render.StatisticsSegment, fmt.Sprintf("[failed: %d]", g.failed))
+
+	return g.renderLine(strings.Join([]string{bar, failed}, " "))
+}
@@ -0,0 +1,177 @@
+/*
+ * The MIT License
+ *
+ * Copyright 2018 kinsey40.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * File:   group_test.go
+ * Author: kinsey40
+ *
+ * Created on 19 October 2026, 11:30
+ *
+ * The test file for group.go
+ *
+ */
+
+package pbar_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kinsey40/pbar"
+	"github.com/kinsey40/pbar/internal/pbartest"
+	"github.com/kinsey40/pbar/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func createGroup(t *testing.T, ctx context.Context, buffer *bytes.Buffer) (*pbar.Group, context.Context) {
+	pbartest.Stub(t, 100, pbartest.At(0))
+
+	var g *pbar.Group
+	if ctx == nil {
+		g = pbar.NewGroup()
+	} else {
+		g, ctx = pbar.GroupWithContext(ctx)
+	}
+	g.Write = &render.Writing{W: buffer}
+
+	return g, ctx
+}
+
+func TestGroup(t *testing.T) {
+	failed := errors.New("Failed!")
+	testCases := []struct {
+		results       []error
+		expectedErr   error
+		expectedFrame string
+	}{
+		{[]error{}, nil, ""},
+		{[]error{nil, nil, nil}, nil, "3.0/3.0 100.0%"},
+		{[]error{nil, failed, nil, nil}, failed, "4.0/4.0 100.0%"},
+	}
+
+	for _, testCase := range testCases {
+		buffer := new(bytes.Buffer)
+		g, _ := createGroup(t, nil, buffer)
+		for _, result := range testCase.results {
+			result := result
+			g.Go(func() error { return result })
+		}
+
+		err := g.Wait()
+		got := buffer.String()
+		expectedFailed := 0
+		for _, result := range testCase.results {
+			if result != nil {
+				expectedFailed++
+			}
+		}
+
+		assert.Equal(t, testCase.expectedErr, err, fmt.Sprintf("Error expected: %v; got: %v", testCase.expectedErr, err))
+		assert.Equal(t, expectedFailed, g.Failed(), fmt.Sprintf("Failed expected: %v; got: %v", expectedFailed, g.Failed()))
+		assert.Contains(t, got, testCase.expectedFrame, fmt.Sprintf("Final frame not rendered: %q", got))
+		if len(testCase.results) > 0 {
+			expectedSuffix := fmt.Sprintf("[failed: %d]\r\n", expectedFailed)
+			assert.True(t, strings.HasSuffix(got, expectedSuffix), fmt.Sprintf("Output incorrect expected suffix: %q; got: %q", expectedSuffix, got))
+		} else {
+			assert.Empty(t, got, fmt.Sprintf("Output rendered for an empty group: %q", got))
+		}
+	}
+}
+
+func TestGroupLineSize(t *testing.T) {
+	testCases := []struct {
+		width       int
+		expectedBar string
+	}{
+		{80, "||"},
+		{100, "|#####|"},
+		{150, "|" + strings.Repeat("#", 55) + "|"},
+	}
+
+	for _, testCase := range testCases {
+		buffer := new(bytes.Buffer)
+		g, _ := createGroup(t, nil, buffer)
+		g.SetUnicode(false)
+		pbartest.Stub(t, testCase.width, pbartest.At(0))
+
+		done := make(chan error, 1)
+		go func() {
+			g.Go(func() error { return nil })
+			g.Go(func() error { return errors.New("Failed!") })
+			done <- g.Wait()
+		}()
+
+		select {
+		case err := <-done:
+			assert.Error(t, err, fmt.Sprintf("Expected error not raised at width: %v", testCase.width))
+		case <-time.After(time.Second * 5):
+			t.Fatalf("The group did not finish at width: %v", testCase.width)
+		}
+
+		got := buffer.String()
+		expected := fmt.Sprintf("\r%s 2.0/2.0 100.0%% [elapsed: 00m:00s, left: 00m:00s, +Inf iters/sec] [failed: 1]\r\n", testCase.expectedBar)
+		assert.True(t, strings.HasSuffix(got, expected), fmt.Sprintf("Final frame expected: %q at width: %v; got: %q", expected, testCase.width, got))
+	}
+}
+
+func TestGroupGrowingTotal(t *testing.T) {
+	buffer := new(bytes.Buffer)
+	g, _ := createGroup(t, nil, buffer)
+
+	release := make(chan struct{})
+	g.Go(func() error { <-release; return nil })
+	assert.Contains(t, buffer.String(), "0.0/1.0 0.0%", fmt.Sprintf("Total not increased: %q", buffer.String()))
+
+	g.Go(func() error { <-release; return nil })
+	assert.Contains(t, buffer.String(), "0.0/2.0 0.0%", fmt.Sprintf("Total not increased: %q", buffer.String()))
+
+	close(release)
+	assert.NoError(t, g.Wait(), fmt.Sprintf("Unexpected error was raised!"))
+	assert.Contains(t, buffer.String(), "2.0/2.0 100.0%", fmt.Sprintf("Final frame not rendered: %q", buffer.String()))
+}
+
+func TestGroupWithContext(t *testing.T) {
+	buffer := new(bytes.Buffer)
+	g, ctx := createGroup(t, context.Background(), buffer)
+	failed := errors.New("Failed!")
+
+	g.Go(func() error { return failed })
+	g.Go(func() error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	err := g.Wait()
+	assert.Equal(t, failed, err, fmt.Sprintf("Error expected: %v; got: %v", failed, err))
+	assert.Equal(t, 2, g.Failed(), fmt.Sprintf("Failed expected: %v; got: %v", 2, g.Failed()))
+	assert.Error(t, ctx.Err(), fmt.Sprintf("Context not cancelled on the first error"))
+
+	g, ctx = createGroup(t, context.Background(), buffer)
+	g.Go(func() error { return nil })
+	assert.NoError(t, g.Wait(), fmt.Sprintf("Unexpected error was raised!"))
+	assert.Error(t, ctx.Err(), fmt.Sprintf("Context not cancelled once Wait returned"))
+}
@@ -0,0 +1,172 @@
+/*
+ * The MIT License
+ *
+ * Copyright 2018 kinsey40.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * File:   hooks.go
+ * Author: kinsey40
+ *
+ * Created on 19 October 2026, 09:45
+ *
+ * Hooks enable functions to be called at points in the lifecycle of a
+ * progress bar: when it starts, on each update, on reaching a percentage and
+ * when it finishes or is aborted.
+ *
+ */
+
+package pbar
+
+import (
+	"sync"
+)
+
+// hooks stores the functions registered on a progress bar
+type hooks struct {
+	start   []func(Snapshot)
+	update  []func(Snapshot)
+	percent []percentHook
+	finish  []func(Snapshot)
+	abort   []func(Snapshot, error)
+
+	started bool
+	stopped bool
+}
+
+// percentHook is called once the progress bar reaches the percentage
+type percentHook struct {
+	percent float64
+	fn      func(Snapshot)
+	called  bool
+}
+
+// OnStart registers a function which is called when the
+// progress bar first displays its progress.
+func (itr *Iterator) OnStart(fn func(Snapshot)) {
+	itr.hooks.start = append(itr.hooks.start, fn)
+}
+
+// OnUpdate registers a function which is called each
+// time the progress bar displays its progress.
+func (itr *Iterator) OnUpdate(fn func(Snapshot)) {
+	itr.hooks.update = append(itr.hooks.update, fn)
+}
+
+// OnPercent registers a function which is called once, when the
+// progress bar first reaches or passes the percentage.
+func (itr *Iterator) OnPercent(percent float64, fn func(Snapshot)) {
+	itr.hooks.percent = append(itr.hooks.percent, percentHook{percent: percent, fn: fn})
+}
+
+// OnFinish registers a function which is called when
+// the progress bar reaches its stop value.
+func (itr *Iterator) OnFinish(fn func(Snapshot)) {
+	itr.hooks.finish = append(itr.hooks.finish, fn)
+}
+
+// OnAbort registers a function which is called when the progress bar
+// is aborted, with the error it was aborted with.
+func (itr *Iterator) OnAbort(fn func(Snapshot, error)) {
+	itr.hooks.abort = append(itr.hooks.abort, fn)
+}
+
+// notify publishes a Snapshot of the progress bar to its Registry, records
+// and checkpoints it if required, and queues the registered functions which
+// are due, with the Snapshot. The functions are called once the lock is released,
+// so that they can use the progress bar. The functions of a Pool or Group
+// may be called from several goroutines at once. The lock must be held
+// by the caller.
+func (itr *Iterator) notify() {
+	itr.publish()
+	itr.record()
+	itr.checkpoint()
+	h := &itr.hooks
+	if len(h.start)+len(h.update)+len(h.percent)+len(h.finish)+len(h.abort) == 0 || h.stopped {
+		return
+	}
+
+	s := itr.snapshot()
+	calls := make([]func(), 0)
+	if !h.started && itr.Clock.IsStartTimeSet() == nil {
+		h.started = true
+		for _, fn := range h.start {
+			fn := fn
+			calls = append(calls, func() { fn(s) })
+		}
+	}
+
+	if s.State != Aborted {
+		for _, fn := range h.update {
+			fn := fn
+			calls = append(calls, func() { fn(s) })
+		}
+
+		for index := range h.percent {
+			hook := &h.percent[index]
+			if !hook.called && s.Percent >= hook.percent {
+				hook.called = true
+				fn := hook.fn
+				calls = append(calls, func() { fn(s) })
+			}
+		}
+	}
+
+	switch s.State {
+	case Finished:
+		h.stopped = true
+		for _, fn := range h.finish {
+			fn := fn
+			calls = append(calls, func() { fn(s) })
+		}
+	case Aborted:
+		h.stopped = true
+		err := itr.err
+		for _, fn := range h.abort {
+			fn := fn
+			calls = append(calls, func() { fn(s, err) })
+		}
+	}
+
+	root := itr.root()
+	root.pending = append(root.pending, calls...)
+}
+
+// reset enables the functions to be called again, when
+// the progress bar is reused.
+func (h *hooks) reset() {
+	h.started = false
+	h.stopped = false
+	for index := range h.percent {
+		h.percent[index].called = false
+	}
+}
+
+// unlock releases the lock and then calls the functions queued whilst
+// the lock was held, in the order they were queued.
+func (itr *Iterator) unlock(mutex *sync.Mutex) {
+	root := itr.root()
+	pending := root.pending
+	root.pending = nil
+	mutex.Unlock()
+
+	for _, call := range pending {
+		call()
+	}
+}
@@ -0,0 +1,168 @@
+/*
+ * The MIT License
+ *
+ * Copyright 2018 kinsey40.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * File:   hooks_test.go
+ * Author: kinsey40
+ *
+ * Created on 19 October 2026, 10:30
+ *
+ * The test file for hooks.go
+ *
+ */
+
+package pbar_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/kinsey40/pbar"
+	"github.com/kinsey40/pbar/internal/pbartest"
+	"github.com/stretchr/testify/assert"
+)
+
+type hookCalls struct {
+	mutex    *sync.Mutex
+	started  []float64
+	updated  []float64
+	percents []float64
+	finished []pbar.State
+	aborted  []error
+}
+
+func registerHooks(itr pbar.Iterate, calls *hookCalls, percents ...float64) {
+	calls.mutex = new(sync.Mutex)
+	record := func(fn func()) {
+		calls.mutex.Lock()
+		defer calls.mutex.Unlock()
+		fn()
+	}
+
+	itr.OnStart(func(s pbar.Snapshot) { record(func() { calls.started = append(calls.started, s.Current) }) })
+	itr.OnUpdate(func(s pbar.Snapshot) { record(func() { calls.updated = append(calls.updated, s.Current) }) })
+	itr.OnFinish(func(s pbar.Snapshot) { record(func() { calls.finished = append(calls.finished, s.State) }) })
+	itr.OnAbort(func(s pbar.Snapshot, err error) { record(func() { calls.aborted = append(calls.aborted, err) }) })
+	for _, percent := range percents {
+		itr.OnPercent(percent, func(s pbar.Snapshot) { record(func() { calls.percents = append(calls.percents, s.Percent) }) })
+	}
+}
+
+func TestHooks(t *testing.T) {
+	failed := errors.New("Failed!")
+	testCases := []struct {
+		updates  int
+		abort    bool
+		expected hookCalls
+	}{
+		{
+			0, false,
+			hookCalls{started: []float64{0.0}, updated: []float64{0.0}, percents: []float64{0.0}},
+		},
+		{
+			2, false,
+			hookCalls{started: []float64{0.0}, updated: []float64{0.0, 1.0, 2.0}, percents: []float64{0.0, 50.0}},
+		},
+		{
+			4, false,
+			hookCalls{started: []float64{0.0}, updated: []float64{0.0, 1.0, 2.0, 3.0, 4.0}, percents: []float64{0.0, 50.0, 75.0}, finished: []pbar.State{pbar.Finished}},
+		},
+		{
+			1, true,
+			hookCalls{started: []float64{0.0}, updated: []float64{0.0, 1.0}, percents: []float64{0.0}, aborted: []error{failed}},
+		},
+	}
+
+	for _, testCase := range testCases {
+		itr := pbartest.NewBar(t, new(bytes.Buffer), pbartest.At(0), 3)
+		itr.Values.SetStop(4.0)
+
+		calls := new(hookCalls)
+		registerHooks(itr, calls, 0.0, 50.0, 60.0)
+		itr.Initialize()
+		for index := 0; index < testCase.updates; index++ {
+			itr.Update()
+		}
+
+		if testCase.abort {
+			itr.Abort(failed)
+			itr.Abort(failed)
+		}
+
+		assert.Equal(t, testCase.expected.started, calls.started, fmt.Sprintf("OnStart called incorrectly expected: %v; got: %v", testCase.expected.started, calls.started))
+		assert.Equal(t, testCase.expected.updated, calls.updated, fmt.Sprintf("OnUpdate called incorrectly expected: %v; got: %v", testCase.expected.updated, calls.updated))
+		assert.Equal(t, testCase.expected.percents, calls.percents, fmt.Sprintf("OnPercent called incorrectly expected: %v; got: %v", testCase.expected.percents, calls.percents))
+		assert.Equal(t, testCase.expected.finished, calls.finished, fmt.Sprintf("OnFinish called incorrectly expected: %v; got: %v", testCase.expected.finished, calls.finished))
+		assert.Equal(t, testCase.expected.aborted, calls.aborted, fmt.Sprintf("OnAbort called incorrectly expected: %v; got: %v", testCase.expected.aborted, calls.aborted))
+	}
+}
+
+func TestHooksTree(t *testing.T) {
+	failed := errors.New("Failed!")
+	parent := pbartest.NewBar(t, new(bytes.Buffer), pbartest.At(0), 3)
+	parent.SetAggregate(true)
+	parentCalls := new(hookCalls)
+	registerHooks(parent, parentCalls)
+	parent.Initialize()
+
+	child, _ := parent.Child(2, "Child")
+	childCalls := new(hookCalls)
+	registerHooks(child, childCalls)
+	child.Initialize()
+	child.Update()
+	parent.Abort(failed)
+
+	assert.Equal(t, []float64{0.0, 1.0}, childCalls.updated, fmt.Sprintf("Child updates incorrect: %v", childCalls.updated))
+	assert.Equal(t, []error{failed}, childCalls.aborted, fmt.Sprintf("Child not aborted with its parent: %v", childCalls.aborted))
+	assert.Equal(t, []float64{0.0, 0.0, 0.5}, parentCalls.updated, fmt.Sprintf("Parent updates incorrect: %v", parentCalls.updated))
+	assert.Equal(t, []error{failed}, parentCalls.aborted, fmt.Sprintf("Parent not aborted: %v", parentCalls.aborted))
+}
+
+func TestHooksUseProgressBar(t *testing.T) {
+	buffer := new(bytes.Buffer)
+	itr := pbartest.NewBar(t, buffer, pbartest.At(0), 3)
+	itr.OnPercent(50.0, func(s pbar.Snapshot) { itr.Printf("Halfway: %.1f", s.Current) })
+	itr.OnFinish(func(s pbar.Snapshot) { itr.Println("Finished") })
+	itr.Values.SetStop(2.0)
+
+	itr.Initialize()
+	itr.Update()
+	itr.Update()
+
+	assert.Contains(t, buffer.String(), "Halfway: 1.0\n", fmt.Sprintf("Hook did not print: %q", buffer.String()))
+	assert.Contains(t, buffer.String(), "\nFinished\n", fmt.Sprintf("Hook did not print: %q", buffer.String()))
+}
+
+func TestHooksGroup(t *testing.T) {
+	g, _ := createGroup(t, nil, new(bytes.Buffer))
+	calls := new(hookCalls)
+	registerHooks(g, calls)
+	g.Go(func() error { return nil })
+	g.Go(func() error { return nil })
+	g.Wait()
+
+	assert.Equal(t, []pbar.State{pbar.Finished}, calls.finished, fmt.Sprintf("Group did not finish: %v", calls.finished))
+	assert.Len(t, calls.started, 1, fmt.Sprintf("Group started incorrectly: %v", calls.started))
+}
@@ -0,0 +1,141 @@
+/*
+ * The MIT License
+ *
+ * Copyright 2018 kinsey40.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * File:   http.go
+ * Author: kinsey40
+ *
+ * Created on 19 October 2026, 17:50
+ *
+ * Handler serves the progress bars of a Registry over HTTP, as a JSON
+ * listing and as a stream of Server-Sent Events, so the progress of a job
+ * can be watched remotely (e.g. using a browser or curl).
+ *
+ */
+
+package pbar
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// EventsPath is the path, relative to the Handler, of the event stream
+var EventsPath = "/events"
+
+// Handler is an http.Handler serving the progress bars of a Registry.
+// A request for the EventsPath is sent a stream of Server-Sent Events,
+// any other request is sent a JSON listing of the progress bars.
+type Handler struct {
+	registry *Registry
+}
+
+// NewHandler creates a Handler for the progress bars in the Registry,
+// a nil Registry means the DefaultRegistry:
+//
+//	http.Handle("/progress/", pbar.NewHandler(nil))
+//
+// GET /progress/ lists the progress bars, as a JSON object of their
+// Snapshots keyed by name (see Registry), e.g.
+//
+//	{"Files":{"desc":"Files","current":3,"total":10,"percent":30,...}}
+//
+// GET /progress/events streams the updates of the progress bars, each event
+// is a JSON object, as in the listing, of the progress bars which have been
+// updated since the last event. The first event lists all the progress bars,
+// and the final update of a progress bar is sent once it finishes or aborts:
+//
+//	event: progress
+//	data: {"Files":{"desc":"Files","current":4,"total":10,"percent":40,...}}
+func NewHandler(registry *Registry) *Handler {
+	if registry == nil {
+		registry = DefaultRegistry
+	}
+
+	return &Handler{registry: registry}
+}
+
+// ServeHTTP serves either the listing or the event stream
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, "Method not allowed!", http.StatusMethodNotAllowed)
+		return
+	}
+
+	if strings.HasSuffix(r.URL.Path, EventsPath) {
+		h.serveEvents(w, r)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(h.registry.Snapshots())
+}
+
+// serveEvents streams the updates of the progress bars
+// until the client disconnects.
+func (h *Handler) serveEvents(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "Streaming is not supported!", http.StatusInternalServerError)
+		return
+	}
+
+	watcher := h.registry.watch()
+	defer h.registry.unwatch(watcher)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+
+	snapshots := h.registry.Snapshots()
+	for {
+		if err := writeEvent(w, snapshots); err != nil {
+			return
+		}
+		flusher.Flush()
+
+		snapshots = nil
+		for len(snapshots) == 0 {
+			select {
+			case <-watcher.signal:
+				snapshots = h.registry.updates(watcher)
+			case <-r.Context().Done():
+				return
+			}
+		}
+	}
+}
+
+// writeEvent writes the Snapshots as a progress event
+func writeEvent(w http.ResponseWriter, snapshots map[string]Snapshot) error {
+	data, err := json.Marshal(snapshots)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(w, "event: progress\ndata: %s\n\n", data)
+
+	return err
+}
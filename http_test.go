@@ -0,0 +1,172 @@
+/*
+ * The MIT License
+ *
+ * Copyright 2018 kinsey40.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * File:   http_test.go
+ * Author: kinsey40
+ *
+ * Created on 19 October 2026, 17:50
+ *
+ * The test file for http.go
+ *
+ */
+
+package pbar_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/kinsey40/pbar"
+	"github.com/stretchr/testify/assert"
+)
+
+func createHandlerBar(t *testing.T, registry *pbar.Registry, description string) *pbar.Iterator {
+	itr := createJSONBar(t, new(bytes.Buffer), nil, 2)
+	itr.SetRegistry(registry)
+	itr.SetDescription(description)
+
+	return itr
+}
+
+func TestHandlerListing(t *testing.T) {
+	testCases := []struct {
+		method         string
+		path           string
+		expectedStatus int
+		expectedBars   map[string]float64
+	}{
+		{"GET", "/", http.StatusOK, map[string]float64{"Work": 1.0, "Other": 0.0}},
+		{"GET", "/progress/", http.StatusOK, map[string]float64{"Work": 1.0, "Other": 0.0}},
+		{"POST", "/", http.StatusMethodNotAllowed, nil},
+	}
+
+	registry := pbar.NewRegistry()
+	work := createHandlerBar(t, registry, "Work")
+	work.Initialize()
+	work.Update()
+	other := createHandlerBar(t, registry, "Other")
+	other.Initialize()
+
+	server := httptest.NewServer(pbar.NewHandler(registry))
+	defer server.Close()
+
+	for _, testCase := range testCases {
+		request, _ := http.NewRequest(testCase.method, server.URL+testCase.path, nil)
+		response, err := http.DefaultClient.Do(request)
+		assert.NoError(t, err, fmt.Sprintf("Unexpected error(%v) was raised!", err))
+		assert.Equal(t, testCase.expectedStatus, response.StatusCode, fmt.Sprintf("Status incorrect expected: %v; got: %v", testCase.expectedStatus, response.StatusCode))
+
+		if testCase.expectedBars != nil {
+			records := make(map[string]jsonRecord)
+			err = json.NewDecoder(response.Body).Decode(&records)
+			assert.NoError(t, err, fmt.Sprintf("Unexpected error(%v) was raised!", err))
+
+			bars := make(map[string]float64)
+			for name, record := range records {
+				bars[name] = record.Current
+			}
+			assert.Equal(t, testCase.expectedBars, bars, fmt.Sprintf("Listing incorrect expected: %v; got: %v", testCase.expectedBars, bars))
+		}
+
+		response.Body.Close()
+	}
+}
+
+func TestHandlerEvents(t *testing.T) {
+	registry := pbar.NewRegistry()
+	server := httptest.NewServer(pbar.NewHandler(registry))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	request, _ := http.NewRequest("GET", server.URL+"/events", nil)
+	response, err := http.DefaultClient.Do(request.WithContext(ctx))
+	assert.NoError(t, err, fmt.Sprintf("Unexpected error(%v) was raised!", err))
+	defer response.Body.Close()
+
+	contentType := response.Header.Get("Content-Type")
+	assert.Equal(t, "text/event-stream", contentType, fmt.Sprintf("Content type incorrect: %v", contentType))
+
+	reader := bufio.NewReader(response.Body)
+	first := readEvent(t, reader)
+	assert.Empty(t, first, fmt.Sprintf("First event incorrect: %v", first))
+
+	itr := createHandlerBar(t, registry, "Work")
+	itr.Initialize()
+	testCases := []struct {
+		update        bool
+		expectedState string
+	}{
+		{false, "running"},
+		{true, "running"},
+		{true, "finished"},
+	}
+
+	for index, testCase := range testCases {
+		if testCase.update {
+			itr.Update()
+		}
+
+		expected := float64(index)
+		var record jsonRecord
+		for record.Current != expected || record.State != testCase.expectedState {
+			event := readEvent(t, reader)
+			if _, ok := event["Work"]; !ok {
+				t.Fatalf("Event missing the progress bar: %v", event)
+			}
+			record = event["Work"]
+		}
+	}
+
+	listing := registry.Snapshots()
+	assert.Equal(t, pbar.Finished, listing["Work"].State, fmt.Sprintf("Finished progress bar not listed: %v", listing))
+}
+
+// readEvent reads the data of the next event from the stream
+func readEvent(t *testing.T, reader *bufio.Reader) map[string]jsonRecord {
+	event := make(map[string]jsonRecord)
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			t.Fatalf("Unexpected error(%v) was raised!", err)
+		}
+
+		line = strings.TrimSpace(line)
+		if line == "" {
+			return event
+		}
+
+		if strings.HasPrefix(line, "data: ") {
+			err = json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &event)
+			assert.NoError(t, err, fmt.Sprintf("Unexpected error(%v) was raised for: %q", err, line))
+		}
+	}
+}
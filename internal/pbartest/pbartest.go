@@ -0,0 +1,91 @@
+/*
+ * The MIT License
+ *
+ * Copyright 2018 kinsey40.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * File:   pbartest.go
+ * Author: kinsey40
+ *
+ * Created on 19 October 2026, 09:30
+ *
+ * Package pbartest provides the helpers shared by the tests of pbar and its
+ * sub-packages, which stub the terminal and clock used by the render package.
+ *
+ */
+
+package pbartest
+
+import (
+	"io"
+	"testing"
+	"time"
+
+	"github.com/kinsey40/pbar"
+	"github.com/kinsey40/pbar/render"
+)
+
+// Stub replaces the clock of the render package with now and the terminal
+// with one which is width columns wide. The originals are restored once
+// the test completes.
+func Stub(t *testing.T, width int, now func() time.Time) {
+	nowTime, getTerminal, terminalSize := render.NowTime, render.GetTerminal, render.TerminalSize
+	t.Cleanup(func() {
+		render.NowTime = nowTime
+		render.GetTerminal = getTerminal
+		render.TerminalSize = terminalSize
+	})
+
+	render.NowTime = now
+	render.GetTerminal = func() uintptr { return 0 }
+	render.TerminalSize = func(_ int) (int, int, error) { return width, 0, nil }
+}
+
+// At returns a clock which is always the given number of seconds
+// after the Unix epoch.
+func At(seconds int64) func() time.Time {
+	return func() time.Time { return time.Unix(seconds, 0) }
+}
+
+// Ticking returns a clock which moves forward by one second each
+// time it is read, starting one second after the Unix epoch.
+func Ticking() func() time.Time {
+	seconds := int64(0)
+	return func() time.Time {
+		seconds++
+		return time.Unix(seconds, 0)
+	}
+}
+
+// NewBar creates a progress bar from the values, as with pbar.Pbar, which
+// is drawn to w on a terminal 100 columns wide with the clock now.
+func NewBar(t *testing.T, w io.Writer, now func() time.Time, values ...interface{}) *pbar.Iterator {
+	Stub(t, 100, now)
+
+	p, err := pbar.Pbar(values...)
+	if err != nil {
+		t.Fatalf("Unexpected error(%v) was raised!", err)
+	}
+
+	itr := p.(*pbar.Iterator)
+	itr.Write = &render.Writing{W: w}
+
+	return itr
+}
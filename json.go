@@ -0,0 +1,133 @@
+/*
+ * The MIT License
+ *
+ * Copyright 2018 kinsey40.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * File:   json.go
+ * Author: kinsey40
+ *
+ * Created on 19 October 2026, 15:20
+ *
+ * JSON enables the progress bar to be written as JSON Lines, one JSON object
+ * per update, for tools which consume the output of a program rather than a
+ * person watching the terminal.
+ *
+ */
+
+package pbar
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"time"
+)
+
+// jsonRecord is a single update of the progress bar written as JSON.
+// The time remaining and rate are null until they are known.
+type jsonRecord struct {
+	Description string   `json:"desc"`
+	Current     float64  `json:"current"`
+	Total       float64  `json:"total"`
+	Percent     float64  `json:"percent"`
+	ElapsedMs   int64    `json:"elapsed_ms"`
+	ETAMs       *int64   `json:"eta_ms"`
+	Rate        *float64 `json:"rate"`
+	State       string   `json:"state"`
+}
+
+// SetJSONOutput writes each update of the progress bar to the writer as
+// a JSON object on its own line, instead of drawing the progress bar:
+//
+//	{"desc":"Files","current":3,"total":10,"percent":30,"elapsed_ms":1500,"eta_ms":3500,"rate":2,"state":"running"}
+//
+// Children of the progress bar also write their updates to the writer.
+//
+// Default Value: nil (the progress bar is drawn)
+func (itr *Iterator) SetJSONOutput(w io.Writer) {
+	itr.jsonOutput = w
+}
+
+// writeJSON writes a Snapshot of the progress bar as a JSON object
+func (itr *Iterator) writeJSON() error {
+	if itr.jsonOutput == nil {
+		return errors.New("JSON output is nil!")
+	}
+
+	line, err := json.Marshal(itr.snapshot())
+	if err != nil {
+		return err
+	}
+
+	_, err = itr.jsonOutput.Write(append(line, '\n'))
+
+	return err
+}
+
+// MarshalJSON encodes the Snapshot in the format written by SetJSONOutput
+func (s Snapshot) MarshalJSON() ([]byte, error) {
+	record := jsonRecord{
+		Description: s.Description,
+		Current:     s.Current,
+		Total:       s.Total,
+		Percent:     s.Percent,
+		ElapsedMs:   int64(s.Elapsed / time.Millisecond),
+		State:       s.State.String(),
+	}
+
+	if s.Estimated {
+		etaMs := int64(s.ETA / time.Millisecond)
+		record.ETAMs = &etaMs
+		record.Rate = &s.Rate
+	}
+
+	return json.Marshal(record)
+}
+
+// UnmarshalJSON decodes a Snapshot in the format written by SetJSONOutput
+func (s *Snapshot) UnmarshalJSON(data []byte) error {
+	var record jsonRecord
+	if err := json.Unmarshal(data, &record); err != nil {
+		return err
+	}
+
+	state, err := parseState(record.State)
+	if err != nil {
+		return err
+	}
+
+	*s = Snapshot{
+		Description: record.Description,
+		Current:     record.Current,
+		Total:       record.Total,
+		Percent:     record.Percent,
+		Elapsed:     time.Duration(record.ElapsedMs) * time.Millisecond,
+		State:       state,
+	}
+
+	if record.ETAMs != nil && record.Rate != nil {
+		s.ETA = time.Duration(*record.ETAMs) * time.Millisecond
+		s.Rate = *record.Rate
+		s.Estimated = true
+	}
+
+	return nil
+}
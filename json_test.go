@@ -0,0 +1,167 @@
+/*
+ * The MIT License
+ *
+ * Copyright 2018 kinsey40.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * File:   json_test.go
+ * Author: kinsey40
+ *
+ * Created on 19 October 2026, 16:05
+ *
+ * The test file for json.go
+ *
+ */
+
+package pbar_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kinsey40/pbar"
+	"github.com/kinsey40/pbar/internal/pbartest"
+	"github.com/stretchr/testify/assert"
+)
+
+type jsonRecord struct {
+	Description string   `json:"desc"`
+	Current     float64  `json:"current"`
+	Total       float64  `json:"total"`
+	Percent     float64  `json:"percent"`
+	ElapsedMs   int64    `json:"elapsed_ms"`
+	ETAMs       *int64   `json:"eta_ms"`
+	Rate        *float64 `json:"rate"`
+	State       string   `json:"state"`
+}
+
+func readJSONRecords(t *testing.T, buffer *bytes.Buffer) []jsonRecord {
+	records := make([]jsonRecord, 0)
+	for _, line := range strings.Split(strings.TrimSpace(buffer.String()), "\n") {
+		var record jsonRecord
+		err := json.Unmarshal([]byte(line), &record)
+		assert.NoError(t, err, fmt.Sprintf("Unexpected error(%v) was raised for: %q", err, line))
+		records = append(records, record)
+	}
+
+	return records
+}
+
+func createJSONBar(t *testing.T, terminal, output io.Writer, values ...interface{}) *pbar.Iterator {
+	itr := pbartest.NewBar(t, terminal, pbartest.Ticking(), values...)
+	itr.SetJSONOutput(output)
+
+	return itr
+}
+
+func TestJSONOutput(t *testing.T) {
+	terminal := new(bytes.Buffer)
+	output := new(bytes.Buffer)
+	itr := createJSONBar(t, terminal, output, 4)
+	itr.SetDescription("Work")
+	itr.Initialize()
+	for index := 0; index < 4; index++ {
+		itr.Update()
+	}
+
+	records := readJSONRecords(t, output)
+	assert.Empty(t, terminal.String(), fmt.Sprintf("Progress bar drawn with JSON output: %q", terminal.String()))
+	assert.Len(t, records, 5, fmt.Sprintf("Number of records incorrect: %v", records))
+
+	first := records[0]
+	assert.Equal(t, jsonRecord{Description: "Work", Total: 4.0, ElapsedMs: 1000, State: "running"}, first, fmt.Sprintf("First record incorrect: %+v", first))
+
+	second := records[1]
+	assert.Equal(t, 1.0, second.Current, fmt.Sprintf("Current expected: %v; got: %v", 1.0, second.Current))
+	assert.Equal(t, 25.0, second.Percent, fmt.Sprintf("Percent expected: %v; got: %v", 25.0, second.Percent))
+	assert.Equal(t, int64(2000), second.ElapsedMs, fmt.Sprintf("Elapsed expected: %v; got: %v", 2000, second.ElapsedMs))
+	assert.Equal(t, int64(6000), *second.ETAMs, fmt.Sprintf("ETA expected: %v; got: %v", 6000, *second.ETAMs))
+	assert.Equal(t, 0.5, *second.Rate, fmt.Sprintf("Rate expected: %v; got: %v", 0.5, *second.Rate))
+
+	last := records[len(records)-1]
+	assert.Equal(t, 4.0, last.Current, fmt.Sprintf("Current expected: %v; got: %v", 4.0, last.Current))
+	assert.Equal(t, 100.0, last.Percent, fmt.Sprintf("Percent expected: %v; got: %v", 100.0, last.Percent))
+	assert.Equal(t, int64(0), *last.ETAMs, fmt.Sprintf("ETA expected: %v; got: %v", 0, *last.ETAMs))
+	assert.Equal(t, "finished", last.State, fmt.Sprintf("State expected: %v; got: %v", "finished", last.State))
+}
+
+func TestJSONOutputAborted(t *testing.T) {
+	terminal := new(bytes.Buffer)
+	output := new(bytes.Buffer)
+	itr := createJSONBar(t, terminal, output, 4)
+	itr.Initialize()
+	itr.Update()
+	itr.Abort(errors.New("Failed!"))
+	itr.Println("Hello")
+
+	records := readJSONRecords(t, output)
+	last := records[len(records)-1]
+	assert.Equal(t, "Hello\n", terminal.String(), fmt.Sprintf("Terminal output incorrect: %q", terminal.String()))
+	assert.Equal(t, 1.0, last.Current, fmt.Sprintf("Current expected: %v; got: %v", 1.0, last.Current))
+	assert.Equal(t, "aborted", last.State, fmt.Sprintf("State expected: %v; got: %v", "aborted", last.State))
+}
+
+func TestJSONOutputTree(t *testing.T) {
+	terminal := new(bytes.Buffer)
+	output := new(bytes.Buffer)
+	parent := createJSONBar(t, terminal, output, 1)
+	parent.SetDescription("Parent")
+	parent.SetAggregate(true)
+	parent.Initialize()
+
+	child, _ := parent.Child(2, "Child")
+	child.Initialize()
+	child.Update()
+	child.Update()
+
+	records := readJSONRecords(t, output)
+	descriptions := make([]string, 0, len(records))
+	for _, record := range records {
+		descriptions = append(descriptions, fmt.Sprintf("%s %.1f", record.Description, record.Current))
+	}
+
+	expected := []string{"Parent 0.0", "Parent 0.0", "Child 0.0", "Parent 0.5", "Child 1.0", "Parent 1.0", "Child 2.0"}
+	assert.Empty(t, terminal.String(), fmt.Sprintf("Progress bar drawn with JSON output: %q", terminal.String()))
+	assert.Equal(t, expected, descriptions, fmt.Sprintf("Records expected: %v; got: %v", expected, descriptions))
+	assert.Equal(t, "finished", records[len(records)-2].State, fmt.Sprintf("Parent not finished: %+v", records[len(records)-2]))
+}
+
+func TestSnapshotMarshalJSON(t *testing.T) {
+	testCases := []struct {
+		snapshot pbar.Snapshot
+		expected string
+	}{
+		{pbar.Snapshot{Description: "Work", Total: 4.0, Elapsed: time.Second}, `{"desc":"Work","current":0,"total":4,"percent":0,"elapsed_ms":1000,"eta_ms":null,"rate":null,"state":"running"}`},
+		{pbar.Snapshot{"Work", 1.0, 4.0, 25.0, time.Second * 2, time.Second * 6, 0.5, true, pbar.Running}, `{"desc":"Work","current":1,"total":4,"percent":25,"elapsed_ms":2000,"eta_ms":6000,"rate":0.5,"state":"running"}`},
+		{pbar.Snapshot{"Work", 2.0, 4.0, 50.0, time.Second, 0, 0, false, pbar.Aborted}, `{"desc":"Work","current":2,"total":4,"percent":50,"elapsed_ms":1000,"eta_ms":null,"rate":null,"state":"aborted"}`},
+	}
+
+	for _, testCase := range testCases {
+		output, err := json.Marshal(testCase.snapshot)
+		assert.NoError(t, err, fmt.Sprintf("Unexpected error(%v) was raised!", err))
+		assert.Equal(t, testCase.expected, string(output), fmt.Sprintf("JSON incorrect expected: %v; got: %s", testCase.expected, output))
+	}
+}
@@ -6,6 +6,7 @@ package mocks
 
 import (
 	gomock "github.com/golang/mock/gomock"
+	render "github.com/kinsey40/pbar/render"
 	reflect "reflect"
 	time "time"
 )
@@ -71,6 +72,18 @@ func (mr *MockClockMockRecorder) SetStartTime() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetStartTime", reflect.TypeOf((*MockClock)(nil).SetStartTime))
 }
 
+// AddElapsed mocks base method
+func (m *MockClock) AddElapsed(arg0 time.Duration) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddElapsed", arg0)
+}
+
+// AddElapsed indicates an expected call of AddElapsed
+func (mr *MockClockMockRecorder) AddElapsed(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddElapsed", reflect.TypeOf((*MockClock)(nil).AddElapsed), arg0)
+}
+
 // Start mocks base method
 func (m *MockClock) Start() time.Time {
 	m.ctrl.T.Helper()
@@ -141,6 +154,35 @@ func (mr *MockClockMockRecorder) IsStartTimeSet() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsStartTimeSet", reflect.TypeOf((*MockClock)(nil).IsStartTimeSet))
 }
 
+// Speed mocks base method
+func (m *MockClock) Speed(arg0, arg1, arg2 float64) (time.Duration, time.Duration, float64, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Speed", arg0, arg1, arg2)
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(time.Duration)
+	ret2, _ := ret[2].(float64)
+	ret3, _ := ret[3].(bool)
+	return ret0, ret1, ret2, ret3
+}
+
+// Speed indicates an expected call of Speed
+func (mr *MockClockMockRecorder) Speed(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Speed", reflect.TypeOf((*MockClock)(nil).Speed), arg0, arg1, arg2)
+}
+
+// SetUnit mocks base method
+func (m *MockClock) SetUnit(arg0 render.Unit) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetUnit", arg0)
+}
+
+// SetUnit indicates an expected call of SetUnit
+func (mr *MockClockMockRecorder) SetUnit(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUnit", reflect.TypeOf((*MockClock)(nil).SetUnit), arg0)
+}
+
 // CreateSpeedMeter mocks base method
 func (m *MockClock) CreateSpeedMeter(arg0, arg1, arg2 float64) string {
 	m.ctrl.T.Helper()
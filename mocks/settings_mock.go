@@ -6,6 +6,7 @@ package mocks
 
 import (
 	gomock "github.com/golang/mock/gomock"
+	render "github.com/kinsey40/pbar/render"
 	reflect "reflect"
 )
 
@@ -140,6 +141,114 @@ func (mr *MockSettingsMockRecorder) SetSuffix(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSuffix", reflect.TypeOf((*MockSettings)(nil).SetSuffix), arg0)
 }
 
+// SetTheme mocks base method
+func (m *MockSettings) SetTheme(arg0 render.Theme) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTheme", arg0)
+}
+
+// SetTheme indicates an expected call of SetTheme
+func (mr *MockSettingsMockRecorder) SetTheme(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTheme", reflect.TypeOf((*MockSettings)(nil).SetTheme), arg0)
+}
+
+// SetColourMode mocks base method
+func (m *MockSettings) SetColourMode(arg0 render.ColourMode) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetColourMode", arg0)
+}
+
+// SetColourMode indicates an expected call of SetColourMode
+func (mr *MockSettingsMockRecorder) SetColourMode(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetColourMode", reflect.TypeOf((*MockSettings)(nil).SetColourMode), arg0)
+}
+
+// SetGradient mocks base method
+func (m *MockSettings) SetGradient(arg0 render.Gradient) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetGradient", arg0)
+}
+
+// SetGradient indicates an expected call of SetGradient
+func (mr *MockSettingsMockRecorder) SetGradient(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetGradient", reflect.TypeOf((*MockSettings)(nil).SetGradient), arg0)
+}
+
+// SetThresholds mocks base method
+func (m *MockSettings) SetThresholds(arg0 []render.Threshold) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetThresholds", arg0)
+}
+
+// SetThresholds indicates an expected call of SetThresholds
+func (mr *MockSettingsMockRecorder) SetThresholds(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetThresholds", reflect.TypeOf((*MockSettings)(nil).SetThresholds), arg0)
+}
+
+// SetStatus mocks base method
+func (m *MockSettings) SetStatus(arg0 render.Status) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetStatus", arg0)
+}
+
+// SetStatus indicates an expected call of SetStatus
+func (mr *MockSettingsMockRecorder) SetStatus(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetStatus", reflect.TypeOf((*MockSettings)(nil).SetStatus), arg0)
+}
+
+// SetPercentage mocks base method
+func (m *MockSettings) SetPercentage(arg0 float64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetPercentage", arg0)
+}
+
+// SetPercentage indicates an expected call of SetPercentage
+func (mr *MockSettingsMockRecorder) SetPercentage(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPercentage", reflect.TypeOf((*MockSettings)(nil).SetPercentage), arg0)
+}
+
+// SetPreset mocks base method
+func (m *MockSettings) SetPreset(arg0 render.Preset) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetPreset", arg0)
+}
+
+// SetPreset indicates an expected call of SetPreset
+func (mr *MockSettingsMockRecorder) SetPreset(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPreset", reflect.TypeOf((*MockSettings)(nil).SetPreset), arg0)
+}
+
+// SetUnicode mocks base method
+func (m *MockSettings) SetUnicode(arg0 bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetUnicode", arg0)
+}
+
+// SetUnicode indicates an expected call of SetUnicode
+func (mr *MockSettingsMockRecorder) SetUnicode(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUnicode", reflect.TypeOf((*MockSettings)(nil).SetUnicode), arg0)
+}
+
+// SetTaskbar mocks base method
+func (m *MockSettings) SetTaskbar(arg0 bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTaskbar", arg0)
+}
+
+// SetTaskbar indicates an expected call of SetTaskbar
+func (mr *MockSettingsMockRecorder) SetTaskbar(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTaskbar", reflect.TypeOf((*MockSettings)(nil).SetTaskbar), arg0)
+}
+
 // SetIdealLineSize mocks base method
 func (m *MockSettings) SetIdealLineSize() error {
 	m.ctrl.T.Helper()
@@ -280,6 +389,132 @@ func (mr *MockSettingsMockRecorder) GetSuffix() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSuffix", reflect.TypeOf((*MockSettings)(nil).GetSuffix))
 }
 
+// GetTheme mocks base method
+func (m *MockSettings) GetTheme() render.Theme {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTheme")
+	ret0, _ := ret[0].(render.Theme)
+	return ret0
+}
+
+// GetTheme indicates an expected call of GetTheme
+func (mr *MockSettingsMockRecorder) GetTheme() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTheme", reflect.TypeOf((*MockSettings)(nil).GetTheme))
+}
+
+// GetColourMode mocks base method
+func (m *MockSettings) GetColourMode() render.ColourMode {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetColourMode")
+	ret0, _ := ret[0].(render.ColourMode)
+	return ret0
+}
+
+// GetColourMode indicates an expected call of GetColourMode
+func (mr *MockSettingsMockRecorder) GetColourMode() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetColourMode", reflect.TypeOf((*MockSettings)(nil).GetColourMode))
+}
+
+// GetGradient mocks base method
+func (m *MockSettings) GetGradient() render.Gradient {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGradient")
+	ret0, _ := ret[0].(render.Gradient)
+	return ret0
+}
+
+// GetGradient indicates an expected call of GetGradient
+func (mr *MockSettingsMockRecorder) GetGradient() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGradient", reflect.TypeOf((*MockSettings)(nil).GetGradient))
+}
+
+// GetThresholds mocks base method
+func (m *MockSettings) GetThresholds() []render.Threshold {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetThresholds")
+	ret0, _ := ret[0].([]render.Threshold)
+	return ret0
+}
+
+// GetThresholds indicates an expected call of GetThresholds
+func (mr *MockSettingsMockRecorder) GetThresholds() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetThresholds", reflect.TypeOf((*MockSettings)(nil).GetThresholds))
+}
+
+// GetStatus mocks base method
+func (m *MockSettings) GetStatus() render.Status {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatus")
+	ret0, _ := ret[0].(render.Status)
+	return ret0
+}
+
+// GetStatus indicates an expected call of GetStatus
+func (mr *MockSettingsMockRecorder) GetStatus() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatus", reflect.TypeOf((*MockSettings)(nil).GetStatus))
+}
+
+// GetPercentage mocks base method
+func (m *MockSettings) GetPercentage() float64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPercentage")
+	ret0, _ := ret[0].(float64)
+	return ret0
+}
+
+// GetPercentage indicates an expected call of GetPercentage
+func (mr *MockSettingsMockRecorder) GetPercentage() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPercentage", reflect.TypeOf((*MockSettings)(nil).GetPercentage))
+}
+
+// GetPreset mocks base method
+func (m *MockSettings) GetPreset() render.Preset {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPreset")
+	ret0, _ := ret[0].(render.Preset)
+	return ret0
+}
+
+// GetPreset indicates an expected call of GetPreset
+func (mr *MockSettingsMockRecorder) GetPreset() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPreset", reflect.TypeOf((*MockSettings)(nil).GetPreset))
+}
+
+// GetTaskbar mocks base method
+func (m *MockSettings) GetTaskbar() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTaskbar")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// GetTaskbar indicates an expected call of GetTaskbar
+func (mr *MockSettingsMockRecorder) GetTaskbar() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskbar", reflect.TypeOf((*MockSettings)(nil).GetTaskbar))
+}
+
+// Paint mocks base method
+func (m *MockSettings) Paint(arg0 render.Segment, arg1 string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Paint", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Paint indicates an expected call of Paint
+func (mr *MockSettingsMockRecorder) Paint(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Paint", reflect.TypeOf((*MockSettings)(nil).Paint), arg0, arg1)
+}
+
 // CreateBarString mocks base method
 func (m *MockSettings) CreateBarString(arg0 int) string {
 	m.ctrl.T.Helper()
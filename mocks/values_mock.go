@@ -6,6 +6,7 @@ package mocks
 
 import (
 	gomock "github.com/golang/mock/gomock"
+	render "github.com/kinsey40/pbar/render"
 	reflect "reflect"
 )
 
@@ -92,6 +93,18 @@ func (mr *MockValuesMockRecorder) SetIsObject(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetIsObject", reflect.TypeOf((*MockValues)(nil).SetIsObject), arg0)
 }
 
+// SetUnit mocks base method
+func (m *MockValues) SetUnit(arg0 render.Unit) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetUnit", arg0)
+}
+
+// SetUnit indicates an expected call of SetUnit
+func (mr *MockValuesMockRecorder) SetUnit(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUnit", reflect.TypeOf((*MockValues)(nil).SetUnit), arg0)
+}
+
 // GetStart mocks base method
 func (m *MockValues) GetStart() float64 {
 	m.ctrl.T.Helper()
@@ -162,6 +175,34 @@ func (mr *MockValuesMockRecorder) GetIsObject() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIsObject", reflect.TypeOf((*MockValues)(nil).GetIsObject))
 }
 
+// GetUnit mocks base method
+func (m *MockValues) GetUnit() render.Unit {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnit")
+	ret0, _ := ret[0].(render.Unit)
+	return ret0
+}
+
+// GetUnit indicates an expected call of GetUnit
+func (mr *MockValuesMockRecorder) GetUnit() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnit", reflect.TypeOf((*MockValues)(nil).GetUnit))
+}
+
+// Percentage mocks base method
+func (m *MockValues) Percentage() float64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Percentage")
+	ret0, _ := ret[0].(float64)
+	return ret0
+}
+
+// Percentage indicates an expected call of Percentage
+func (mr *MockValuesMockRecorder) Percentage() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Percentage", reflect.TypeOf((*MockValues)(nil).Percentage))
+}
+
 // Statistics mocks base method
 func (m *MockValues) Statistics(arg0 int) (string, int) {
 	m.ctrl.T.Helper()
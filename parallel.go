@@ -0,0 +1,325 @@
+/*
+ * The MIT License
+ *
+ * Copyright 2018 kinsey40.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * File:   parallel.go
+ * Author: kinsey40
+ *
+ * Created on 19 October 2026, 14:20
+ *
+ * Parallel runs a function over each item of a slice or array using a pool
+ * of worker goroutines, driving a single progress bar safely from all of the
+ * workers. The errors returned by the function are collected together.
+ *
+ */
+
+package pbar
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/kinsey40/pbar/render"
+)
+
+// WorkerFunc is performed on each item by the workers of a Pool
+type WorkerFunc func(ctx context.Context, item interface{}) error
+
+// MapFunc is performed on each item by the workers of a Pool,
+// the results are returned in the same order as the items.
+type MapFunc func(ctx context.Context, item interface{}) (interface{}, error)
+
+// The formats of the per-worker status lines
+var (
+	WorkerBusyFormat = "worker %d: item %d"
+	WorkerIdleFormat = "worker %d: idle"
+)
+
+// ItemError records the error returned by the function for a single item
+type ItemError struct {
+	Index int
+	Item  interface{}
+	Err   error
+}
+
+// Error returns the error message, prefixed by the index of the item
+func (e *ItemError) Error() string {
+	return fmt.Sprintf("Item %d: %v", e.Index, e.Err)
+}
+
+// Unwrap returns the error returned by the function
+func (e *ItemError) Unwrap() error {
+	return e.Err
+}
+
+// Errors holds all of the errors returned whilst running a Pool
+type Errors []error
+
+// Error returns the error messages joined together
+func (e Errors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, err := range e {
+		messages = append(messages, err.Error())
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+// Pool runs a function over a collection of items using a number of
+// worker goroutines, whilst displaying a single progress bar. The progress
+// bar can be altered using the Set*() functions before the Pool is run.
+type Pool struct {
+	*Iterator
+	Workers int
+
+	workerLines bool
+	workers     []*Iterator
+	next        int
+	errs        Errors
+}
+
+// NewPool creates a Pool with the given number of workers
+func NewPool(workers int) *Pool {
+	return &Pool{
+		Iterator: makeIteratorObject().(*Iterator),
+		Workers:  workers,
+	}
+}
+
+// ParallelForEach performs fn on each of the items (a slice or array) using
+// the given number of workers, displaying the progress in a single progress
+// bar. Every item is processed, the errors returned are collected into Errors.
+func ParallelForEach(ctx context.Context, items interface{}, workers int, fn WorkerFunc) error {
+	return NewPool(workers).ForEach(ctx, items, fn)
+}
+
+// ParallelMap performs fn on each of the items (a slice or array) using
+// the given number of workers, returning the results in the order of the
+// items. The errors returned are collected into Errors.
+func ParallelMap(ctx context.Context, items interface{}, workers int, fn MapFunc) ([]interface{}, error) {
+	return NewPool(workers).Map(ctx, items, fn)
+}
+
+// SetWorkerLines sets whether a status line is displayed beneath the
+// progress bar for each worker, showing the item it is working on.
+//
+// Default Value: false
+func (p *Pool) SetWorkerLines(value bool) {
+	p.workerLines = value
+}
+
+// ForEach performs fn on each of the items (a slice or array), see
+// ParallelForEach. If the context is cancelled, no further items are
+// started and the progress bar is aborted.
+func (p *Pool) ForEach(ctx context.Context, items interface{}, fn WorkerFunc) error {
+	return p.run(ctx, items, func(ctx context.Context, _ int, item interface{}) error {
+		return fn(ctx, item)
+	})
+}
+
+// Map performs fn on each of the items (a slice or array), see ParallelMap.
+// The results of items which were not processed, or which returned an
+// error, are nil.
+func (p *Pool) Map(ctx context.Context, items interface{}, fn MapFunc) ([]interface{}, error) {
+	value, err := p.check(ctx, items)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]interface{}, value.Len())
+	err = p.run(ctx, items, func(ctx context.Context, index int, item interface{}) error {
+		result, err := fn(ctx, item)
+		results[index] = result
+
+		return err
+	})
+
+	return results, err
+}
+
+// check ensures the items are a slice or array, and that
+// the context and the number of workers are valid.
+func (p *Pool) check(ctx context.Context, items interface{}) (reflect.Value, error) {
+	value := reflect.ValueOf(items)
+	if value.Kind() != reflect.Slice && value.Kind() != reflect.Array {
+		return value, fmt.Errorf("Type: %v is not a slice or array!", reflect.TypeOf(items))
+	}
+
+	if p.Workers < 1 {
+		return value, fmt.Errorf("Workers: %d must be at least one!", p.Workers)
+	}
+
+	if ctx == nil {
+		return value, errors.New("Context is nil!")
+	}
+
+	return value, nil
+}
+
+// run starts the workers and waits for them to process every item
+func (p *Pool) run(ctx context.Context, items interface{}, fn func(context.Context, int, interface{}) error) error {
+	value, err := p.check(ctx, items)
+	if err != nil {
+		return err
+	}
+
+	if value.Len() == 0 {
+		return nil
+	}
+
+	p.createIteratorFromObject(items)
+	p.WithContext(ctx)
+	p.state = Running
+	p.err = nil
+	p.hooks.reset()
+	p.suffixWritten = false
+	p.drawn = 0
+	p.next = 0
+	p.errs = nil
+	p.workers = nil
+	if p.workerLines && p.jsonOutput == nil {
+		p.SetFinishedChildren(RemoveFinished)
+		for index := 0; index < p.Workers; index++ {
+			worker := &Iterator{parent: p.Iterator, line: fmt.Sprintf(WorkerIdleFormat, index+1)}
+			p.workers = append(p.workers, worker)
+		}
+		p.children = p.workers
+	}
+
+	p.Initialize()
+
+	var wg sync.WaitGroup
+	for index := 0; index < p.Workers; index++ {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			p.work(ctx, worker, value, fn)
+		}(index)
+	}
+	wg.Wait()
+
+	mutex := p.lock()
+	defer mutex.Unlock()
+
+	if p.next < value.Len() && ctx.Err() != nil {
+		p.errs = append(p.errs, ctx.Err())
+	}
+
+	if len(p.errs) > 0 {
+		return p.errs
+	}
+
+	return nil
+}
+
+// work takes the next item, performs fn on it and moves the progress bar
+// forward, until there are no items remaining or the context is cancelled.
+func (p *Pool) work(ctx context.Context, worker int, items reflect.Value, fn func(context.Context, int, interface{}) error) {
+	for {
+		index, ok := p.take(ctx, worker, items.Len())
+		if !ok {
+			return
+		}
+
+		item := items.Index(index).Interface()
+		err := fn(ctx, index, item)
+		p.complete(worker, index, item, err, items.Len())
+	}
+}
+
+// take returns the index of the next item to be processed, if
+// there is one, and updates the status line of the worker.
+func (p *Pool) take(ctx context.Context, worker, total int) (int, bool) {
+	mutex := p.lock()
+	defer mutex.Unlock()
+
+	if p.next >= total || ctx.Err() != nil {
+		p.setWorkerLine(worker, "")
+		return 0, false
+	}
+
+	index := p.next
+	p.next++
+	if p.setWorkerLine(worker, fmt.Sprintf(WorkerBusyFormat, worker+1, index)) {
+		if p.next >= total {
+			p.removeIdleWorkers()
+		}
+		p.refreshTree()
+	}
+
+	return index, true
+}
+
+// complete records the error for an item, if there is one, and moves
+// the progress bar forward. The status line of the worker is removed
+// once there are no items remaining.
+func (p *Pool) complete(worker, index int, item interface{}, err error, total int) {
+	mutex := p.lock()
+	defer p.unlock(mutex)
+
+	if err != nil {
+		p.errs = append(p.errs, &ItemError{Index: index, Item: item, Err: err})
+		p.Settings.SetStatus(render.StatusError)
+	}
+
+	line := fmt.Sprintf(WorkerIdleFormat, worker+1)
+	if p.next >= total {
+		line = ""
+	}
+	p.setWorkerLine(worker, line)
+
+	if p.state == Running {
+		p.Clock.Now()
+		p.progress()
+	}
+}
+
+// removeIdleWorkers removes the status lines of the workers which are
+// not processing an item, once every item has been started.
+func (p *Pool) removeIdleWorkers() {
+	for index, worker := range p.workers {
+		if worker.line == fmt.Sprintf(WorkerIdleFormat, index+1) {
+			worker.setState(Finished)
+		}
+	}
+}
+
+// setWorkerLine sets the status line of the worker, an empty line
+// removes the worker from the display. It returns false if the
+// worker lines are not displayed.
+func (p *Pool) setWorkerLine(worker int, line string) bool {
+	if worker >= len(p.workers) {
+		return false
+	}
+
+	if line == "" {
+		p.workers[worker].setState(Finished)
+	} else {
+		p.workers[worker].line = line
+	}
+
+	return true
+}
@@ -0,0 +1,208 @@
+/*
+ * The MIT License
+ *
+ * Copyright 2018 kinsey40.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * File:   parallel_test.go
+ * Author: kinsey40
+ *
+ * Created on 19 October 2026, 16:45
+ *
+ * The test file for parallel.go
+ *
+ */
+
+package pbar_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kinsey40/pbar"
+	"github.com/kinsey40/pbar/internal/pbartest"
+	"github.com/kinsey40/pbar/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func createPool(t *testing.T, workers int, buffer *bytes.Buffer) *pbar.Pool {
+	pbartest.Stub(t, 100, pbartest.At(0))
+
+	p := pbar.NewPool(workers)
+	p.Write = &render.Writing{W: buffer}
+
+	return p
+}
+
+func TestParallelArguments(t *testing.T) {
+	testCases := []struct {
+		ctx     context.Context
+		items   interface{}
+		workers int
+	}{
+		{context.Background(), 5, 2},
+		{context.Background(), "abc", 2},
+		{context.Background(), []int{1, 2}, 0},
+		{nil, []int{1, 2}, 2},
+	}
+
+	for _, testCase := range testCases {
+		err := pbar.ParallelForEach(testCase.ctx, testCase.items, testCase.workers, func(_ context.Context, _ interface{}) error { return nil })
+		assert.Error(t, err, fmt.Sprintf("Expected error was not raised for: %v", testCase.items))
+
+		results, err := pbar.ParallelMap(testCase.ctx, testCase.items, testCase.workers, func(_ context.Context, _ interface{}) (interface{}, error) { return nil, nil })
+		assert.Error(t, err, fmt.Sprintf("Expected error was not raised for: %v", testCase.items))
+		assert.Empty(t, results, fmt.Sprintf("Results not empty: %v", results))
+	}
+}
+
+func TestPoolForEach(t *testing.T) {
+	testCases := []struct {
+		items   []int
+		workers int
+	}{
+		{[]int{}, 2},
+		{[]int{1, 2, 3}, 1},
+		{[]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, 3},
+		{[]int{1, 2}, 8},
+	}
+
+	for _, testCase := range testCases {
+		buffer := new(bytes.Buffer)
+		p := createPool(t, testCase.workers, buffer)
+
+		var mutex sync.Mutex
+		sum := 0
+		err := p.ForEach(context.Background(), testCase.items, func(_ context.Context, item interface{}) error {
+			mutex.Lock()
+			defer mutex.Unlock()
+			sum += item.(int)
+
+			return nil
+		})
+
+		expectedSum := 0
+		for _, item := range testCase.items {
+			expectedSum += item
+		}
+
+		expectedFrame := fmt.Sprintf("%d.0/%d.0 100.0%%", len(testCase.items), len(testCase.items))
+		if len(testCase.items) == 0 {
+			expectedFrame = ""
+		}
+
+		assert.NoError(t, err, fmt.Sprintf("Unexpected error(%v) was raised!", err))
+		assert.Equal(t, expectedSum, sum, fmt.Sprintf("Sum expected: %v; got: %v", expectedSum, sum))
+		assert.Contains(t, buffer.String(), expectedFrame, fmt.Sprintf("Final frame not rendered: %q", buffer.String()))
+		assert.NoError(t, p.Err(), fmt.Sprintf("Unexpected error(%v) was raised!", p.Err()))
+	}
+}
+
+func TestPoolErrors(t *testing.T) {
+	buffer := new(bytes.Buffer)
+	p := createPool(t, 3, buffer)
+	failed := errors.New("Failed!")
+
+	err := p.ForEach(context.Background(), []int{0, 1, 2, 3, 4, 5}, func(_ context.Context, item interface{}) error {
+		if item.(int)%2 == 0 {
+			return failed
+		}
+
+		return nil
+	})
+
+	errs, ok := err.(pbar.Errors)
+	assert.True(t, ok, fmt.Sprintf("Error is not of type Errors: %T", err))
+	assert.Len(t, errs, 3, fmt.Sprintf("Number of errors incorrect: %v", errs))
+	for _, e := range errs {
+		itemErr := e.(*pbar.ItemError)
+		assert.Equal(t, 0, itemErr.Index%2, fmt.Sprintf("Error for incorrect item: %v", itemErr.Index))
+		assert.Equal(t, failed, itemErr.Unwrap(), fmt.Sprintf("Error not wrapped: %v", itemErr.Unwrap()))
+	}
+
+	assert.Contains(t, err.Error(), "Item ", fmt.Sprintf("Error message incorrect: %v", err))
+	assert.Contains(t, buffer.String(), "6.0/6.0 100.0%", fmt.Sprintf("Final frame not rendered: %q", buffer.String()))
+}
+
+func TestPoolMap(t *testing.T) {
+	buffer := new(bytes.Buffer)
+	p := createPool(t, 4, buffer)
+
+	items := []string{"a", "b", "c", "d", "e", "f", "g"}
+	results, err := p.Map(context.Background(), items, func(_ context.Context, item interface{}) (interface{}, error) {
+		return strings.ToUpper(item.(string)), nil
+	})
+
+	expected := []interface{}{"A", "B", "C", "D", "E", "F", "G"}
+	assert.NoError(t, err, fmt.Sprintf("Unexpected error(%v) was raised!", err))
+	assert.Equal(t, expected, results, fmt.Sprintf("Results expected: %v; got: %v", expected, results))
+}
+
+func TestPoolCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	buffer := new(bytes.Buffer)
+	p := createPool(t, 2, buffer)
+
+	var mutex sync.Mutex
+	processed := 0
+	err := p.ForEach(ctx, make([]int, 100), func(_ context.Context, _ interface{}) error {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		processed++
+		if processed == 5 {
+			cancel()
+		}
+
+		return nil
+	})
+
+	errs, _ := err.(pbar.Errors)
+	assert.Len(t, errs, 1, fmt.Sprintf("Number of errors incorrect: %v", errs))
+	assert.Equal(t, context.Canceled, errs[0], fmt.Sprintf("Error expected: %v; got: %v", context.Canceled, errs[0]))
+	assert.True(t, processed < 100, fmt.Sprintf("All items processed after cancel: %v", processed))
+	assert.Eventually(t, func() bool { return p.Err() != nil }, time.Second, time.Millisecond)
+}
+
+func TestPoolWorkerLines(t *testing.T) {
+	buffer := new(bytes.Buffer)
+	p := createPool(t, 2, buffer)
+	p.SetDescription("Pool")
+	p.SetWorkerLines(true)
+
+	err := p.ForEach(context.Background(), []int{1, 2, 3, 4}, func(_ context.Context, _ interface{}) error { return nil })
+
+	got := buffer.String()
+	frames := strings.Split(got, "\033[J")
+	lastFrame := frames[len(frames)-2]
+	assert.NoError(t, err, fmt.Sprintf("Unexpected error(%v) was raised!", err))
+	assert.Regexp(t, "worker [12]: item [0-3]", got, fmt.Sprintf("Worker line not rendered: %q", got))
+	assert.Contains(t, lastFrame, "4.0/4.0 100.0%", fmt.Sprintf("Final frame incorrect: %q", lastFrame))
+	assert.NotContains(t, lastFrame, "worker", fmt.Sprintf("Worker lines in final frame: %q", lastFrame))
+	assert.True(t, strings.HasSuffix(got, "\033[J\r\n"), fmt.Sprintf("Suffix not written at the end: %q", got))
+}
@@ -35,10 +35,15 @@
 package pbar
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"io"
+	"math"
 	"reflect"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/kinsey40/pbar/render"
 )
@@ -55,17 +60,50 @@ import (
 type Iterate interface {
 	Initialize() error
 	Update() error
+	Add(float64) error
+	SetTotal(float64) error
+	SetUnit(render.Unit)
 	SetDescription(string)
 	SetFinishedIterationSymbol(string)
 	SetCurrentIterationSymbol(string)
 	SetRemainingIterationSymbol(string)
 	SetLParen(string)
 	SetRParen(string)
+	SetPreset(string) error
+	SetUnicode(bool)
+	SetTaskbar(bool)
+	SetTitle(bool)
 	SetRetain(bool)
+	SetTheme(render.Theme)
+	SetColourMode(render.ColourMode)
+	SetGradient(render.Gradient)
+	SetThresholds(...render.Threshold)
+	SetStatus(render.Status)
 	SetEqualTo()
 	Multi()
 	MultiEnd()
-
+	Child(interface{}, string) (Iterate, error)
+	SetAggregate(bool)
+	SetFinishedChildren(FinishedChildren)
+	WithContext(context.Context)
+	Next() bool
+	Err() error
+	Abort(error) error
+	Println(...interface{}) error
+	Printf(string, ...interface{}) error
+	Writer() io.Writer
+	SetJSONOutput(io.Writer)
+	SetRecorder(io.Writer)
+	SetCheckpoint(string, time.Duration)
+	OnStart(func(Snapshot))
+	OnUpdate(func(Snapshot))
+	OnPercent(float64, func(Snapshot))
+	OnFinish(func(Snapshot))
+	OnAbort(func(Snapshot, error))
+	Snapshot() Snapshot
+	SetRegistry(*Registry)
+
+	iterator() *Iterator
 	progress() error
 	createIteratorFromObject(interface{})
 	createIteratorFromValues(...interface{})
@@ -79,6 +117,34 @@ type Iterator struct {
 	Clock    render.Clock
 	Settings render.Settings
 	Write    render.Write
+
+	parent             *Iterator
+	children           []*Iterator
+	aggregate          bool
+	finishedChildren   FinishedChildren
+	line               string
+	fraction           float64
+	shown              float64
+	jsonOutput         io.Writer
+	recorder           io.Writer
+	recorded           bool
+	checkpointPath     string
+	checkpointInterval time.Duration
+	checkpointed       time.Duration
+	registry           *Registry
+	registryName       string
+	ascii              bool
+	title              bool
+	titleSaved         bool
+	hooks              hooks
+	pending            []func()
+	state              State
+	err                error
+	ctx                context.Context
+	done               chan struct{}
+	mutex              sync.Mutex
+	suffixWritten      bool
+	drawn              int
 }
 
 // makeIteratorObject creates an Iterate interface
@@ -88,6 +154,7 @@ func makeIteratorObject() Iterate {
 	itr.Settings = render.NewSettings()
 	itr.Values = render.NewValues()
 	itr.Write = render.NewWrite()
+	itr.ascii = !render.SupportsUnicode()
 
 	return itr
 }
@@ -119,28 +186,114 @@ func Pbar(values ...interface{}) (Iterate, error) {
 
 // Initialize sets the internal timer to start,
 // enabling output relating to the time taken for
-// iterations within the progress bar.
+// iterations within the progress bar. An error finding the size of the
+// terminal (e.g. when the output is redirected) does not stop the progress
+// bar, which is then drawn at the default width by the following updates,
+// so it can be ignored when the work matters more than the display.
 func (itr *Iterator) Initialize() error {
-	itr.Clock.SetStartTime()
-	if err := itr.Settings.SetIdealLineSize(); err != nil {
+	mutex := itr.lock()
+	err := itr.start()
+	mutex.Unlock()
+	if err != nil {
+		return err
+	}
+
+	if err := itr.Update(); err != nil {
 		return err
 	}
 
-	return itr.Update()
+	itr.watch()
+
+	return nil
 }
 
 // Update moves the iteration forward by one step. This should
 // be performed at the end of the iteration sequence
 // (i.e. at the end of the for-loop).
 func (itr *Iterator) Update() error {
+	mutex := itr.lock()
+	defer itr.unlock(mutex)
+
 	if err := itr.Clock.IsStartTimeSet(); err != nil {
 		panic(err)
 	}
+
+	if itr.state == Aborted {
+		return itr.err
+	}
+
+	itr.Clock.Now()
+	if itr.aggregate {
+		itr.refreshAggregate()
+		return itr.refreshTree()
+	}
+
+	return itr.progress()
+}
+
+// Add moves the progress bar forward by n, rather than by one step, e.g. by
+// the number of bytes copied. The progress bar is capped at its stop value.
+func (itr *Iterator) Add(n float64) error {
+	mutex := itr.lock()
+	defer itr.unlock(mutex)
+
+	if err := itr.Clock.IsStartTimeSet(); err != nil {
+		return err
+	}
+
+	if itr.aggregate {
+		return errors.New("Cannot Add to an aggregated progress bar!")
+	}
+
+	if itr.state != Running {
+		return itr.err
+	}
+
+	itr.Clock.Now()
+
+	return itr.moveTo(itr.shown + n)
+}
+
+// SetTotal sets the stop value of the progress bar, e.g. once the size of
+// the work becomes known. If the progress bar is running it is redrawn,
+// finishing the progress bar if the total has already been reached.
+// The total must be greater than the start of the progress bar.
+func (itr *Iterator) SetTotal(total float64) error {
+	mutex := itr.lock()
+	defer itr.unlock(mutex)
+
+	if total <= itr.Values.GetStart() {
+		return fmt.Errorf("Total: %f must be greater than the start: %f!", total, itr.Values.GetStart())
+	}
+
+	itr.Values.SetStop(total)
+	if itr.Clock.IsStartTimeSet() != nil || itr.state != Running || itr.aggregate {
+		itr.Values.SetCurrent(math.Min(itr.Values.GetCurrent(), total))
+		return nil
+	}
+
 	itr.Clock.Now()
 
+	return itr.moveTo(itr.shown)
+}
+
+// moveTo sets the current value of the progress bar, capped at the
+// stop value, and redraws it. The lock must be held by the caller.
+func (itr *Iterator) moveTo(current float64) error {
+	itr.Values.SetCurrent(math.Min(current, itr.Values.GetStop()))
+
 	return itr.progress()
 }
 
+// SetUnit sets the unit in which the values, and the rate, of the
+// progress bar are displayed, e.g. render.UnitBytes.
+//
+// Default Value: render.UnitNone
+func (itr *Iterator) SetUnit(unit render.Unit) {
+	itr.Values.SetUnit(unit)
+	itr.Clock.SetUnit(unit)
+}
+
 // SetDescription sets the Description parameter, which causes the Pbar
 // to output a String at the start of the progress bar, effectively
 // enabling the progress bars to be named within the output.
@@ -193,6 +346,43 @@ func (itr *Iterator) SetRParen(newSymbol string) {
 	itr.Settings.SetRParen(newSymbol)
 }
 
+// SetPreset sets all the symbols used to draw the progress bar from the
+// preset registered under the given name. The built-in presets are:
+// "default", "classic", "blocks", "dots", "arrows", "pip" and "braille".
+// Further presets can be added using render.RegisterPreset.
+func (itr *Iterator) SetPreset(name string) error {
+	preset, err := render.LookupPreset(name)
+	if err != nil {
+		return err
+	}
+
+	itr.Settings.SetPreset(preset)
+
+	return nil
+}
+
+// SetUnicode overrides the detection of Unicode support in the terminal.
+// When false, the default symbols are replaced by ASCII equivalents,
+// when true the Unicode defaults are restored. Symbols which have been
+// set by the user are not changed.
+//
+// Default Value: detected from the TERM, LC_ALL, LC_CTYPE and LANG variables
+func (itr *Iterator) SetUnicode(enabled bool) {
+	itr.Settings.SetUnicode(enabled)
+	itr.ascii = !enabled
+}
+
+// SetTaskbar sets whether the progress is also displayed in the tab or
+// taskbar of terminals supporting the OSC 9;4 escape sequence. A warning
+// Status is displayed as paused, and an error Status (or an aborted
+// progress bar) as the error state. The progress is removed once the
+// progress bar finishes. Only the top progress bar of a tree is displayed.
+//
+// Default Value: false
+func (itr *Iterator) SetTaskbar(taskbar bool) {
+	itr.Settings.SetTaskbar(taskbar)
+}
+
 // SetRetain sets whether to clear the progress bar
 // from the writer (false) or not (true)
 //
@@ -205,6 +395,50 @@ func (itr *Iterator) SetRetain(value bool) {
 	}
 }
 
+// SetTheme sets the colours and styles used for each segment of the
+// progress bar. Built-in themes can be found using render.LookupTheme.
+//
+// Default Value: render.DefaultTheme (no styling)
+func (itr *Iterator) SetTheme(theme render.Theme) {
+	itr.Settings.SetTheme(theme)
+}
+
+// SetColourMode sets the colour depth used when applying the theme.
+// By default this is detected from the writer when the progress bar
+// is initialized: colour is disabled if NO_COLOR is set or the writer
+// is not a terminal.
+//
+// Default Value: render.ColourAuto
+func (itr *Iterator) SetColourMode(mode render.ColourMode) {
+	itr.Settings.SetColourMode(mode)
+}
+
+// SetGradient sets the colours blended across the completed section
+// of the progress bar, e.g. render.TrafficLightGradient.
+//
+// Default Value: nil (the theme colour is used)
+func (itr *Iterator) SetGradient(gradient render.Gradient) {
+	itr.Settings.SetGradient(gradient)
+}
+
+// SetThresholds sets the colour of the completed section of the progress
+// bar based upon the percentage completed. The colour of the highest
+// threshold reached is used.
+//
+// Default Value: nil (the theme colour is used)
+func (itr *Iterator) SetThresholds(thresholds ...render.Threshold) {
+	itr.Settings.SetThresholds(thresholds)
+}
+
+// SetStatus sets the status of the progress bar, this can be called
+// within the for-loop to highlight a warning or an error. The status
+// colour overrides the theme, gradient and thresholds.
+//
+// Default Value: render.StatusNormal
+func (itr *Iterator) SetStatus(status render.Status) {
+	itr.Settings.SetStatus(status)
+}
+
 // SetEqualTo adds an extra step to the stop value
 // This is to be used when the for loop uses an 'equals' value
 // for the upper limit
@@ -235,6 +469,40 @@ func (itr *Iterator) MultiEnd() {
 	}
 }
 
+// iterator returns the Iterator, enabling the Iterator to be
+// found from the types which embed it.
+func (itr *Iterator) iterator() *Iterator {
+	return itr
+}
+
+// start sets the internal timer to start, and sets the line size and
+// colour mode ready for the first render.
+func (itr *Iterator) start() error {
+	itr.Clock.SetStartTime()
+	itr.done = make(chan struct{})
+	if itr.checkpointPath != "" {
+		if err := itr.resume(); err != nil {
+			return err
+		}
+	}
+	if itr.registry != nil {
+		itr.registry.add(itr)
+	}
+	if err := itr.Settings.SetIdealLineSize(); err != nil {
+		return err
+	}
+
+	if depth := itr.depth(); depth > 0 {
+		itr.Settings.SetLineSize(itr.Settings.GetLineSize() - len(ChildIndent)*depth)
+	}
+
+	if itr.Settings.GetColourMode() == render.ColourAuto && itr.Write != nil {
+		itr.Settings.SetColourMode(render.DetectColourMode(itr.Write.GetWriter()))
+	}
+
+	return nil
+}
+
 // progress moves the iteration sequence forward by altering the
 // CurrentValue inside the iterator object
 func (itr *Iterator) progress() error {
@@ -249,12 +517,33 @@ func (itr *Iterator) progress() error {
 	}
 
 	bar := itr.formatProgressBar(start, stop, current, lineSize)
-	if err := itr.render(bar); err != nil {
+	if itr.inTree() {
+		itr.line = bar
+		itr.fraction = fraction(start, stop, current)
+		if current == stop {
+			itr.setState(Finished)
+		}
+
+		itr.notify()
+		if err := itr.refreshTree(); err != nil {
+			return err
+		}
+
+		itr.Values.SetCurrent(current + step)
+
+		return nil
+	}
+
+	if current == stop {
+		itr.setState(Finished)
+	}
+
+	if err := itr.renderLine(bar); err != nil {
 		return err
 	}
 
 	if current == stop {
-		if err := itr.render(itr.Settings.GetSuffix()); err != nil {
+		if err := itr.renderSuffix(); err != nil {
 			return err
 		}
 	}
@@ -277,13 +566,39 @@ func (itr *Iterator) render(s string) error {
 	return nil
 }
 
+// renderLine writes the line of the progress bar to the writer, storing
+// it so that it can be redrawn after printing above the progress bar.
+func (itr *Iterator) renderLine(line string) error {
+	itr.line = line
+	itr.notify()
+	if itr.jsonOutput != nil {
+		return itr.writeJSON()
+	}
+
+	return itr.render(itr.titleSequence() + line)
+}
+
+// renderSuffix writes the suffix to the writer once the progress
+// bar has stopped, unless the progress is written as JSON.
+func (itr *Iterator) renderSuffix() error {
+	if itr.jsonOutput != nil {
+		return nil
+	}
+
+	return itr.render(itr.restoreTitle() + itr.Settings.GetSuffix())
+}
+
 // formatProgressBar creates the progress bar to be displayed
 // by the writer. It gathers all the relevant sections from
 // the other functions.
 func (itr *Iterator) formatProgressBar(start, stop, current float64, lineSize int) string {
+	itr.shown = current
 	statistics, numStepsCompleted := itr.Values.Statistics(lineSize)
+	itr.Settings.SetPercentage(itr.Values.Percentage())
 	barString := itr.Settings.CreateBarString(numStepsCompleted)
 	speedMeter := itr.Clock.CreateSpeedMeter(start, stop, current)
+	statistics = itr.Settings.Paint(render.StatisticsSegment, statistics)
+	speedMeter = itr.Settings.Paint(render.ETASegment, speedMeter)
 	progressBar := strings.Join([]string{barString, statistics, speedMeter}, " ")
 
 	return progressBar
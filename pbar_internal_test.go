@@ -251,8 +251,12 @@ func TestProgress(t *testing.T) {
 
 		if testCase.currentVal > testCase.startVal && testCase.currentVal <= testCase.stopVal {
 			calls = append(calls, mockValues.EXPECT().Statistics(testCase.lineSize).Return(testCase.stats, testCase.numSteps))
+			calls = append(calls, mockValues.EXPECT().Percentage().Return(testCase.currentVal/testCase.stopVal*100.0))
+			calls = append(calls, mockSettings.EXPECT().SetPercentage(testCase.currentVal/testCase.stopVal*100.0))
 			calls = append(calls, mockSettings.EXPECT().CreateBarString(testCase.numSteps).Return(testCase.barString))
 			calls = append(calls, mockClock.EXPECT().CreateSpeedMeter(testCase.startVal, testCase.stopVal, testCase.currentVal).Return(testCase.speedMeter))
+			calls = append(calls, mockSettings.EXPECT().Paint(render.StatisticsSegment, testCase.stats).Return(testCase.stats))
+			calls = append(calls, mockSettings.EXPECT().Paint(render.ETASegment, testCase.speedMeter).Return(testCase.speedMeter))
 
 			if testCase.currentVal == testCase.stopVal {
 				calls = append(calls, mockWrite.EXPECT().WriteString(gomock.Any()).Return(nil))
@@ -423,8 +427,12 @@ func TestFormatProgressBar(t *testing.T) {
 
 		gomock.InOrder(
 			mockValues.EXPECT().Statistics(testCase.lineSize).Return(testCase.stats, testCase.numSteps),
+			mockValues.EXPECT().Percentage().Return(testCase.currentVal/testCase.endVal*100.0),
+			mockSettings.EXPECT().SetPercentage(testCase.currentVal/testCase.endVal*100.0),
 			mockSettings.EXPECT().CreateBarString(testCase.numSteps).Return(testCase.barString),
 			mockClock.EXPECT().CreateSpeedMeter(testCase.startVal, testCase.endVal, testCase.currentVal).Return(testCase.speedMeter),
+			mockSettings.EXPECT().Paint(render.StatisticsSegment, testCase.stats).Return(testCase.stats),
+			mockSettings.EXPECT().Paint(render.ETASegment, testCase.speedMeter).Return(testCase.speedMeter),
 		)
 
 		output := itr.formatProgressBar(testCase.startVal, testCase.endVal, testCase.currentVal, testCase.lineSize)
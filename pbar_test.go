@@ -36,10 +36,12 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"regexp"
 	"testing"
 	"time"
 
 	"github.com/kinsey40/pbar"
+	"github.com/kinsey40/pbar/internal/pbartest"
 	"github.com/kinsey40/pbar/render"
 	"github.com/stretchr/testify/assert"
 )
@@ -512,3 +514,316 @@ func TestMultiEnd(t *testing.T) {
 		}
 	}
 }
+
+func TestSetTheme(t *testing.T) {
+	itr := &pbar.Iterator{}
+	testCases := []struct {
+		theme render.Theme
+	}{
+		{render.Themes["classic"]},
+		{render.Themes["sunset"]},
+	}
+
+	for _, testCase := range testCases {
+		itr.Settings = &render.Set{}
+		itr.SetTheme(testCase.theme)
+		message := fmt.Sprintf("Themes not equal; expected: %v, got: %v", testCase.theme, itr.Settings.GetTheme())
+
+		assert.Equal(
+			t,
+			testCase.theme,
+			itr.Settings.GetTheme(),
+			message,
+		)
+	}
+}
+
+func TestSetColourMode(t *testing.T) {
+	itr := &pbar.Iterator{}
+	testCases := []struct {
+		mode render.ColourMode
+	}{
+		{render.ColourNone},
+		{render.ColourTrue},
+	}
+
+	for _, testCase := range testCases {
+		itr.Settings = &render.Set{}
+		itr.SetColourMode(testCase.mode)
+		message := fmt.Sprintf("ColourModes not equal; expected: %v, got: %v", testCase.mode, itr.Settings.GetColourMode())
+
+		assert.Equal(
+			t,
+			testCase.mode,
+			itr.Settings.GetColourMode(),
+			message,
+		)
+	}
+}
+
+func TestInitializeDetectsColourMode(t *testing.T) {
+	testCases := []struct {
+		mode         render.ColourMode
+		expectedMode render.ColourMode
+	}{
+		{render.ColourAuto, render.ColourNone},
+		{render.Colour256, render.Colour256},
+	}
+
+	for _, testCase := range testCases {
+		pbartest.Stub(t, 100, render.NowTime)
+
+		s := render.NewSettings()
+		s.SetColourMode(testCase.mode)
+		itr := &pbar.Iterator{
+			Clock:    render.NewClock(),
+			Settings: s,
+			Values:   &render.Vals{Stop: 5.0, Step: 1.0},
+			Write:    &render.Writing{W: new(bytes.Buffer)},
+		}
+
+		err := itr.Initialize()
+		message := fmt.Sprintf("ColourMode incorrect expected: %v; got: %v", testCase.expectedMode, s.GetColourMode())
+
+		assert.NoError(t, err, fmt.Sprintf("Unexpected error raised: %v", err))
+		assert.Equal(t, testCase.expectedMode, s.GetColourMode(), message)
+	}
+}
+
+func TestSetGradient(t *testing.T) {
+	itr := &pbar.Iterator{}
+	testCases := []struct {
+		gradient render.Gradient
+	}{
+		{render.TrafficLightGradient},
+		{render.Gradient{render.Blue, render.Cyan}},
+	}
+
+	for _, testCase := range testCases {
+		itr.Settings = &render.Set{}
+		itr.SetGradient(testCase.gradient)
+		message := fmt.Sprintf("Gradients not equal; expected: %v, got: %v", testCase.gradient, itr.Settings.GetGradient())
+
+		assert.Equal(
+			t,
+			testCase.gradient,
+			itr.Settings.GetGradient(),
+			message,
+		)
+	}
+}
+
+func TestSetThresholds(t *testing.T) {
+	itr := &pbar.Iterator{}
+	testCases := []struct {
+		thresholds []render.Threshold
+	}{
+		{[]render.Threshold{{Percentage: 0.0, Colour: render.Red}, {Percentage: 50.0, Colour: render.Green}}},
+	}
+
+	for _, testCase := range testCases {
+		itr.Settings = &render.Set{}
+		itr.SetThresholds(testCase.thresholds...)
+		message := fmt.Sprintf("Thresholds not equal; expected: %v, got: %v", testCase.thresholds, itr.Settings.GetThresholds())
+
+		assert.Equal(
+			t,
+			testCase.thresholds,
+			itr.Settings.GetThresholds(),
+			message,
+		)
+	}
+}
+
+func TestSetStatus(t *testing.T) {
+	itr := &pbar.Iterator{}
+	testCases := []struct {
+		status render.Status
+	}{
+		{render.StatusWarning},
+		{render.StatusError},
+		{render.StatusNormal},
+	}
+
+	for _, testCase := range testCases {
+		itr.Settings = &render.Set{}
+		itr.SetStatus(testCase.status)
+		message := fmt.Sprintf("Status not equal; expected: %v, got: %v", testCase.status, itr.Settings.GetStatus())
+
+		assert.Equal(
+			t,
+			testCase.status,
+			itr.Settings.GetStatus(),
+			message,
+		)
+	}
+}
+
+func TestSetPreset(t *testing.T) {
+	itr := &pbar.Iterator{}
+	testCases := []struct {
+		name           string
+		expectedPreset render.Preset
+		expectError    bool
+	}{
+		{"classic", render.ClassicPreset, false},
+		{"blocks", render.BlocksPreset, false},
+		{"Hello", render.Preset{}, true},
+	}
+
+	for _, testCase := range testCases {
+		itr.Settings = &render.Set{}
+		err := itr.SetPreset(testCase.name)
+		message := fmt.Sprintf("Presets not equal; expected: %v, got: %v", testCase.expectedPreset, itr.Settings.GetPreset())
+
+		if testCase.expectError {
+			assert.Error(t, err, fmt.Sprintf("Expected error not raised"))
+		} else {
+			assert.NoError(t, err, fmt.Sprintf("Unexpected error raised: %v", err))
+		}
+
+		assert.Equal(
+			t,
+			testCase.expectedPreset,
+			itr.Settings.GetPreset(),
+			message,
+		)
+	}
+}
+
+func TestSetUnicode(t *testing.T) {
+	itr := &pbar.Iterator{}
+	testCases := []struct {
+		enabled        bool
+		expectedPreset render.Preset
+	}{
+		{false, render.ASCIIPreset},
+		{true, render.DefaultPreset},
+	}
+
+	for _, testCase := range testCases {
+		itr.Settings = &render.Set{}
+		itr.Settings.SetPreset(render.DefaultPreset)
+		itr.SetUnicode(testCase.enabled)
+		message := fmt.Sprintf("Symbols not equal; expected: %v, got: %v", testCase.expectedPreset, itr.Settings.GetPreset())
+
+		assert.Equal(
+			t,
+			testCase.expectedPreset,
+			itr.Settings.GetPreset(),
+			message,
+		)
+	}
+}
+
+func TestSetTaskbar(t *testing.T) {
+	testCases := []struct {
+		updates           int
+		abort             bool
+		expectedSequences []string
+	}{
+		{2, false, []string{"\033]9;4;1;0\007", "\033]9;4;1;50\007", "\033]9;4;0;0\007"}},
+		{1, true, []string{"\033]9;4;1;0\007", "\033]9;4;1;50\007", "\033]9;4;2;50\007"}},
+	}
+
+	for _, testCase := range testCases {
+		buffer := new(bytes.Buffer)
+		itr := pbartest.NewBar(t, buffer, pbartest.At(0), 2)
+		itr.SetTaskbar(true)
+		itr.Initialize()
+		for index := 0; index < testCase.updates; index++ {
+			itr.Update()
+		}
+
+		if testCase.abort {
+			itr.Abort(nil)
+		}
+
+		sequences := regexp.MustCompile("\033\\]9;4;[0-9]+;[0-9]+\007").FindAllString(buffer.String(), -1)
+		message := fmt.Sprintf("Sequences incorrect expected: %q; got: %q", testCase.expectedSequences, sequences)
+
+		assert.Equal(t, testCase.expectedSequences, sequences, message)
+	}
+}
+
+func TestAdd(t *testing.T) {
+	testCases := []struct {
+		amounts         []float64
+		expectedCurrent float64
+		expectedState   pbar.State
+		expectedOutput  string
+	}{
+		{[]float64{}, 0.0, pbar.Running, "0.0/10.0"},
+		{[]float64{2.5, 4.0}, 6.5, pbar.Running, "6.5/10.0"},
+		{[]float64{6.0, 6.0}, 10.0, pbar.Finished, "10.0/10.0"},
+	}
+
+	for _, testCase := range testCases {
+		buffer := new(bytes.Buffer)
+		itr := pbartest.NewBar(t, buffer, pbartest.At(0), 3)
+		itr.Values.SetStop(10.0)
+		assert.Error(t, itr.Add(1.0), fmt.Sprintf("Expected error not raised before Initialize"))
+
+		itr.Initialize()
+		for _, amount := range testCase.amounts {
+			err := itr.Add(amount)
+			assert.NoError(t, err, fmt.Sprintf("Unexpected error raised: %v", err))
+		}
+
+		s := itr.Snapshot()
+		assert.Equal(t, testCase.expectedCurrent, s.Current, fmt.Sprintf("Current incorrect expected: %v; got: %v", testCase.expectedCurrent, s.Current))
+		assert.Equal(t, testCase.expectedState, s.State, fmt.Sprintf("State incorrect expected: %v; got: %v", testCase.expectedState, s.State))
+		assert.Contains(t, buffer.String(), testCase.expectedOutput, fmt.Sprintf("Output incorrect: %q", buffer.String()))
+	}
+}
+
+func TestSetTotal(t *testing.T) {
+	testCases := []struct {
+		initialize    bool
+		added         float64
+		total         float64
+		expectError   bool
+		expectedTotal float64
+		expectedState pbar.State
+	}{
+		{false, 0.0, 20.0, false, 20.0, pbar.Running},
+		{true, 4.0, 20.0, false, 20.0, pbar.Running},
+		{true, 4.0, 4.0, false, 4.0, pbar.Finished},
+		{true, 4.0, 2.0, false, 2.0, pbar.Finished},
+		{true, 0.0, -1.0, true, 10.0, pbar.Running},
+		{true, 0.0, 0.0, true, 10.0, pbar.Running},
+		{false, 0.0, 0.0, true, 10.0, pbar.Running},
+	}
+
+	for _, testCase := range testCases {
+		itr := pbartest.NewBar(t, new(bytes.Buffer), pbartest.At(0), 3)
+		itr.Values.SetStop(10.0)
+		if testCase.initialize {
+			itr.Initialize()
+			itr.Add(testCase.added)
+		}
+
+		err := itr.SetTotal(testCase.total)
+		if testCase.expectError {
+			assert.Error(t, err, fmt.Sprintf("Expected error not raised"))
+		} else {
+			assert.NoError(t, err, fmt.Sprintf("Unexpected error raised: %v", err))
+		}
+
+		s := itr.Snapshot()
+		assert.Equal(t, testCase.expectedTotal, s.Total, fmt.Sprintf("Total incorrect expected: %v; got: %v", testCase.expectedTotal, s.Total))
+		assert.Equal(t, testCase.expectedState, s.State, fmt.Sprintf("State incorrect expected: %v; got: %v", testCase.expectedState, s.State))
+	}
+}
+
+func TestSetUnit(t *testing.T) {
+	buffer := new(bytes.Buffer)
+	itr := pbartest.NewBar(t, buffer, pbartest.At(0), 3)
+	itr.Values.SetStop(4096.0)
+	itr.SetUnit(render.UnitBytes)
+	itr.Initialize()
+	itr.Add(1024.0)
+
+	assert.Contains(t, buffer.String(), "1.0 KiB/4.0 KiB 25.0%", fmt.Sprintf("Statistics not in bytes: %q", buffer.String()))
+	assert.Contains(t, buffer.String(), "B/s]", fmt.Sprintf("Rate not in bytes: %q", buffer.String()))
+}
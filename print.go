@@ -0,0 +1,132 @@
+/*
+ * The MIT License
+ *
+ * Copyright 2018 kinsey40.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * File:   print.go
+ * Author: kinsey40
+ *
+ * Created on 19 October 2026, 13:25
+ *
+ * Print enables text to be written above the progress bars, without colliding
+ * with them. The progress bars are cleared, the text is written and then the
+ * progress bars are redrawn beneath it.
+ *
+ */
+
+package pbar
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Println writes the operands above the progress bar, as with fmt.Println.
+// The whole tree of progress bars is redrawn beneath the text.
+func (itr *Iterator) Println(a ...interface{}) error {
+	mutex := itr.lock()
+	defer mutex.Unlock()
+
+	return itr.root().printAbove(fmt.Sprintln(a...))
+}
+
+// Printf writes the formatted text above the progress bar, as with
+// fmt.Printf. A newline is added if the text does not end with one.
+func (itr *Iterator) Printf(format string, a ...interface{}) error {
+	mutex := itr.lock()
+	defer mutex.Unlock()
+
+	return itr.root().printAbove(fmt.Sprintf(format, a...))
+}
+
+// Writer returns an io.Writer which writes each line above the progress
+// bar, so that it can be used with log.SetOutput. Text is held back until
+// the end of the line is written.
+func (itr *Iterator) Writer() io.Writer {
+	return &printWriter{itr: itr}
+}
+
+// printAbove clears the progress bars, writes the text and redraws the
+// progress bars beneath it. The lock must be held by the caller.
+func (itr *Iterator) printAbove(text string) error {
+	if itr.Write == nil {
+		return errors.New("Write is nil!")
+	}
+
+	if !strings.HasSuffix(text, "\n") {
+		text += "\n"
+	}
+
+	if itr.state != Running || itr.jsonOutput != nil || (itr.line == "" && itr.drawn == 0) {
+		return itr.Write.WriteString(text)
+	}
+
+	if itr.drawn > 0 {
+		clear := "\r\033[J"
+		if itr.drawn > 1 {
+			clear = fmt.Sprintf("\033[%dA%s", itr.drawn-1, clear)
+		}
+
+		if err := itr.Write.WriteString(clear + text); err != nil {
+			return err
+		}
+
+		itr.drawn = 0
+
+		return itr.redraw()
+	}
+
+	if err := itr.Write.WriteString("\r\033[K" + text); err != nil {
+		return err
+	}
+
+	return itr.render(itr.line)
+}
+
+// printWriter writes complete lines above the progress bar
+type printWriter struct {
+	itr     *Iterator
+	partial []byte
+}
+
+// Write writes each complete line of p above the progress bar, any text
+// after the final newline is held back until the line is completed.
+func (w *printWriter) Write(p []byte) (int, error) {
+	mutex := w.itr.lock()
+	defer mutex.Unlock()
+
+	w.partial = append(w.partial, p...)
+	end := bytes.LastIndexByte(w.partial, '\n')
+	if end < 0 {
+		return len(p), nil
+	}
+
+	text := string(w.partial[:end+1])
+	w.partial = w.partial[end+1:]
+	if err := w.itr.root().printAbove(text); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
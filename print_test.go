@@ -0,0 +1,131 @@
+/*
+ * The MIT License
+ *
+ * Copyright 2018 kinsey40.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * File:   print_test.go
+ * Author: kinsey40
+ *
+ * Created on 19 October 2026, 14:40
+ *
+ * The test file for print.go
+ *
+ */
+
+package pbar_test
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"strings"
+	"testing"
+
+	"github.com/kinsey40/pbar"
+	"github.com/kinsey40/pbar/internal/pbartest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrint(t *testing.T) {
+	testCases := []struct {
+		updates      int
+		print        func(itr *pbar.Iterator) error
+		expectedText string
+		expectedBar  string
+	}{
+		{-1, func(itr *pbar.Iterator) error { return itr.Println("Hello", 1) }, "Hello 1\n", ""},
+		{0, func(itr *pbar.Iterator) error { return itr.Println("Hello", 1) }, "\r\033[KHello 1\n", "0.0/3.0 0.0%"},
+		{1, func(itr *pbar.Iterator) error { return itr.Printf("Value: %d", 2) }, "\r\033[KValue: 2\n", "1.0/3.0 33.3%"},
+		{1, func(itr *pbar.Iterator) error { return itr.Printf("Value: %d\n", 2) }, "\r\033[KValue: 2\n", "1.0/3.0 33.3%"},
+		{3, func(itr *pbar.Iterator) error { return itr.Println("Done") }, "Done\n", ""},
+	}
+
+	for _, testCase := range testCases {
+		buffer := new(bytes.Buffer)
+		itr := pbartest.NewBar(t, buffer, pbartest.At(0), 3)
+		if testCase.updates >= 0 {
+			itr.Initialize()
+		}
+
+		for index := 0; index < testCase.updates; index++ {
+			itr.Update()
+		}
+
+		buffer.Reset()
+		err := testCase.print(itr)
+		got := buffer.String()
+
+		assert.NoError(t, err, fmt.Sprintf("Unexpected error(%v) was raised!", err))
+		assert.True(t, strings.HasPrefix(got, testCase.expectedText), fmt.Sprintf("Output incorrect expected prefix: %q; got: %q", testCase.expectedText, got))
+		if testCase.expectedBar == "" {
+			assert.Equal(t, testCase.expectedText, got, fmt.Sprintf("Bar redrawn expected: %q; got: %q", testCase.expectedText, got))
+		} else {
+			assert.Contains(t, got[len(testCase.expectedText):], testCase.expectedBar, fmt.Sprintf("Bar not redrawn beneath the text: %q", got))
+		}
+	}
+}
+
+func TestPrintTree(t *testing.T) {
+	buffer := new(bytes.Buffer)
+	parent := pbartest.NewBar(t, buffer, pbartest.At(0), 3)
+	parent.SetDescription("Parent")
+	parent.Initialize()
+
+	child, _ := parent.Child(2, "Child")
+	child.Initialize()
+
+	buffer.Reset()
+	child.Println("Hello")
+	got := buffer.String()
+
+	expectedText := "\033[1A\r\033[JHello\n"
+	assert.True(t, strings.HasPrefix(got, expectedText), fmt.Sprintf("Output incorrect expected prefix: %q; got: %q", expectedText, got))
+	assert.NotContains(t, got[len(expectedText):], "\033[1A", fmt.Sprintf("Cursor moved above the text: %q", got))
+	assert.Contains(t, got, "Parent:", fmt.Sprintf("Parent not redrawn: %q", got))
+	assert.Contains(t, got, "  Child:", fmt.Sprintf("Child not redrawn: %q", got))
+
+	buffer.Reset()
+	child.Update()
+	assert.True(t, strings.HasPrefix(buffer.String(), "\033[1A"), fmt.Sprintf("Tree not redrawn in place: %q", buffer.String()))
+}
+
+func TestPrintWriter(t *testing.T) {
+	buffer := new(bytes.Buffer)
+	itr := pbartest.NewBar(t, buffer, pbartest.At(0), 3)
+	itr.Initialize()
+
+	buffer.Reset()
+	w := itr.Writer()
+	n, err := w.Write([]byte("abc"))
+	assert.NoError(t, err, fmt.Sprintf("Unexpected error(%v) was raised!", err))
+	assert.Equal(t, 3, n, fmt.Sprintf("Bytes written expected: %v; got: %v", 3, n))
+	assert.Empty(t, buffer.String(), fmt.Sprintf("Partial line written: %q", buffer.String()))
+
+	w.Write([]byte("def\nxyz"))
+	assert.True(t, strings.HasPrefix(buffer.String(), "\r\033[Kabcdef\n"), fmt.Sprintf("Line not written: %q", buffer.String()))
+	assert.NotContains(t, buffer.String(), "xyz", fmt.Sprintf("Partial line written: %q", buffer.String()))
+
+	buffer.Reset()
+	logger := log.New(w, "", 0)
+	logger.Printf("Hello %s", "log")
+	assert.True(t, strings.HasPrefix(buffer.String(), "\r\033[KxyzHello log\n"), fmt.Sprintf("Log line not written: %q", buffer.String()))
+	assert.Contains(t, buffer.String(), "0.0/3.0 0.0%", fmt.Sprintf("Bar not redrawn beneath the log line: %q", buffer.String()))
+}
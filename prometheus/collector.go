@@ -0,0 +1,127 @@
+/*
+ * The MIT License
+ *
+ * Copyright 2018 kinsey40.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * File:   collector.go
+ * Author: kinsey40
+ *
+ * Created on 19 October 2026, 17:05
+ *
+ * Package prometheus exports the progress of the running progress bars as
+ * Prometheus metrics, so the progress of a job can be scraped rather than
+ * watched in a terminal.
+ *
+ */
+
+package prometheus
+
+import (
+	"github.com/kinsey40/pbar"
+	prom "github.com/prometheus/client_golang/prometheus"
+)
+
+// Namespace prefixes the names of the metrics, e.g. pbar_current
+var Namespace = "pbar"
+
+// Collector is a prometheus.Collector which reports the progress of each
+// progress bar in a pbar.Registry. The metrics are labelled with the name
+// of the progress bar within the Registry (its description):
+//
+//	pbar_current{description="Files"} 3
+//	pbar_total{description="Files"} 10
+//	pbar_rate{description="Files"} 2
+//	pbar_eta_seconds{description="Files"} 3.5
+//	pbar_state{description="Files",state="running"} 1
+//
+// The rate and ETA are only reported once they are known. A progress bar
+// which has finished or been aborted is reported, with that state, until
+// the retention of the Registry has passed (see pbar.Registry.SetRetention).
+type Collector struct {
+	registry *pbar.Registry
+	current  *prom.Desc
+	total    *prom.Desc
+	percent  *prom.Desc
+	elapsed  *prom.Desc
+	rate     *prom.Desc
+	eta      *prom.Desc
+	state    *prom.Desc
+}
+
+// NewCollector creates a Collector for the progress bars in the
+// Registry, a nil Registry means the pbar.DefaultRegistry.
+func NewCollector(registry *pbar.Registry) *Collector {
+	if registry == nil {
+		registry = pbar.DefaultRegistry
+	}
+
+	labels := []string{"description"}
+	return &Collector{
+		registry: registry,
+		current:  newDesc("current", "The current value of the progress bar.", labels),
+		total:    newDesc("total", "The stop value of the progress bar.", labels),
+		percent:  newDesc("percent", "The percentage of the progress bar completed.", labels),
+		elapsed:  newDesc("elapsed_seconds", "The time since the progress bar started.", labels),
+		rate:     newDesc("rate", "The number of iterations per second.", labels),
+		eta:      newDesc("eta_seconds", "The estimated time remaining.", labels),
+		state:    newDesc("state", "The state of the progress bar, 1 for the current state.", append(labels, "state")),
+	}
+}
+
+// Register registers a Collector for the pbar.DefaultRegistry
+// with the registerer, e.g. prometheus.DefaultRegisterer.
+func Register(registerer prom.Registerer) error {
+	return registerer.Register(NewCollector(nil))
+}
+
+// Describe sends the descriptors of the metrics to the channel
+func (c *Collector) Describe(ch chan<- *prom.Desc) {
+	for _, desc := range []*prom.Desc{c.current, c.total, c.percent, c.elapsed, c.rate, c.eta, c.state} {
+		ch <- desc
+	}
+}
+
+// Collect sends the metrics of each progress bar in the Registry to the channel
+func (c *Collector) Collect(ch chan<- prom.Metric) {
+	for name, s := range c.registry.Snapshots() {
+		ch <- prom.MustNewConstMetric(c.current, prom.GaugeValue, s.Current, name)
+		ch <- prom.MustNewConstMetric(c.total, prom.GaugeValue, s.Total, name)
+		ch <- prom.MustNewConstMetric(c.percent, prom.GaugeValue, s.Percent, name)
+		ch <- prom.MustNewConstMetric(c.elapsed, prom.GaugeValue, s.Elapsed.Seconds(), name)
+		if s.Estimated {
+			ch <- prom.MustNewConstMetric(c.rate, prom.GaugeValue, s.Rate, name)
+			ch <- prom.MustNewConstMetric(c.eta, prom.GaugeValue, s.ETA.Seconds(), name)
+		}
+
+		for _, state := range []pbar.State{pbar.Running, pbar.Finished, pbar.Aborted} {
+			value := 0.0
+			if s.State == state {
+				value = 1.0
+			}
+			ch <- prom.MustNewConstMetric(c.state, prom.GaugeValue, value, name, state.String())
+		}
+	}
+}
+
+// newDesc creates the descriptor of a metric within the Namespace
+func newDesc(name, help string, labels []string) *prom.Desc {
+	return prom.NewDesc(prom.BuildFQName(Namespace, "", name), help, labels, nil)
+}
@@ -0,0 +1,142 @@
+/*
+ * The MIT License
+ *
+ * Copyright 2018 kinsey40.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * File:   collector_test.go
+ * Author: kinsey40
+ *
+ * Created on 19 October 2026, 17:05
+ *
+ * The test file for collector.go
+ *
+ */
+
+package prometheus_test
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kinsey40/pbar"
+	"github.com/kinsey40/pbar/internal/pbartest"
+	"github.com/kinsey40/pbar/prometheus"
+	prom "github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/stretchr/testify/assert"
+)
+
+func createBar(t *testing.T, registry *pbar.Registry, description string, stop int) *pbar.Iterator {
+	itr := pbartest.NewBar(t, new(bytes.Buffer), pbartest.Ticking(), stop)
+	itr.SetDescription(description)
+	itr.SetRegistry(registry)
+
+	return itr
+}
+
+func scrape(t *testing.T, registry *pbar.Registry) string {
+	reg := prom.NewRegistry()
+	err := reg.Register(prometheus.NewCollector(registry))
+	assert.NoError(t, err, fmt.Sprintf("Unexpected error raised: %v", err))
+
+	server := httptest.NewServer(promhttp.HandlerFor(reg, promhttp.HandlerOpts{}))
+	defer server.Close()
+
+	response, err := http.Get(server.URL)
+	assert.NoError(t, err, fmt.Sprintf("Unexpected error raised: %v", err))
+	defer response.Body.Close()
+
+	body, _ := ioutil.ReadAll(response.Body)
+	return string(body)
+}
+
+func TestCollector(t *testing.T) {
+	testCases := []struct {
+		updates          int
+		abort            bool
+		expectedLines    []string
+		notExpectedLines []string
+	}{
+		{0, false, []string{
+			`pbar_current{description="Work"} 0`,
+			`pbar_total{description="Work"} 4`,
+			`pbar_elapsed_seconds{description="Work"} 1`,
+			`pbar_state{description="Work",state="running"} 1`,
+			`pbar_state{description="Work",state="finished"} 0`,
+		}, []string{"pbar_rate", "pbar_eta_seconds"}},
+		{1, false, []string{
+			`pbar_current{description="Work"} 1`,
+			`pbar_percent{description="Work"} 25`,
+			`pbar_rate{description="Work"} 0.5`,
+			`pbar_eta_seconds{description="Work"} 6`,
+		}, []string{}},
+		{4, false, []string{
+			`pbar_current{description="Work"} 4`,
+			`pbar_state{description="Work",state="finished"} 1`,
+			`pbar_state{description="Work",state="running"} 0`,
+		}, []string{}},
+		{2, true, []string{
+			`pbar_current{description="Work"} 2`,
+			`pbar_state{description="Work",state="aborted"} 1`,
+			`pbar_state{description="Work",state="running"} 0`,
+		}, []string{}},
+	}
+
+	for _, testCase := range testCases {
+		registry := pbar.NewRegistry()
+		itr := createBar(t, registry, "Work", 4)
+		itr.Initialize()
+		for index := 0; index < testCase.updates; index++ {
+			itr.Update()
+		}
+
+		if testCase.abort {
+			itr.Abort(nil)
+		}
+
+		body := scrape(t, registry)
+		for _, line := range testCase.expectedLines {
+			assert.Contains(t, body, line, fmt.Sprintf("Metric (%v) missing from: %q", line, body))
+		}
+
+		for _, line := range testCase.notExpectedLines {
+			assert.NotContains(t, body, line, fmt.Sprintf("Unexpected metric (%v) in: %q", line, body))
+		}
+	}
+}
+
+func TestCollectorDuplicateDescriptions(t *testing.T) {
+	registry := pbar.NewRegistry()
+	for index := 0; index < 2; index++ {
+		itr := createBar(t, registry, "Work", 4)
+		itr.Initialize()
+	}
+
+	body := scrape(t, registry)
+	for _, name := range []string{"Work", "Work (2)"} {
+		line := fmt.Sprintf(`pbar_total{description=%q} 4`, name)
+		assert.Contains(t, body, line, fmt.Sprintf("Metric (%v) missing from: %q", line, body))
+	}
+}
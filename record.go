@@ -0,0 +1,270 @@
+/*
+ * The MIT License
+ *
+ * Copyright 2018 kinsey40.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * File:   record.go
+ * Author: kinsey40
+ *
+ * Created on 19 October 2026, 22:05
+ *
+ * Record enables the updates of a progress bar to be recorded to a file and
+ * replayed later through the renderer, e.g. to investigate a rendering glitch
+ * or the behaviour of the time remaining.
+ *
+ */
+
+package pbar
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/kinsey40/pbar/render"
+)
+
+// RecordingVersion is the version of the recording format
+const RecordingVersion = 1
+
+// recordHeader is the first line of a recording, containing
+// the settings used to render the progress bar.
+type recordHeader struct {
+	Version                  int         `json:"pbar_recording"`
+	Start                    float64     `json:"start"`
+	Unit                     render.Unit `json:"unit"`
+	LineSize                 int         `json:"line_size"`
+	LParen                   string      `json:"lparen"`
+	RParen                   string      `json:"rparen"`
+	FinishedIterationSymbol  string      `json:"finished"`
+	CurrentIterationSymbol   string      `json:"current"`
+	RemainingIterationSymbol string      `json:"remaining"`
+}
+
+// SetRecorder records the updates of the progress bar to the writer, whilst
+// the progress bar is drawn as normal. The first line of the recording holds
+// the settings of the progress bar, each following line is an update in the
+// format written by SetJSONOutput. The recording can be replayed using
+// NewReplay. Children of the progress bar are not recorded, and recording
+// stops if writing to the writer fails.
+//
+// Default Value: nil (the progress bar is not recorded)
+func (itr *Iterator) SetRecorder(w io.Writer) {
+	itr.recorder = w
+	itr.recorded = false
+}
+
+// record writes a Snapshot of the progress bar to the recorder, preceded
+// by the settings of the progress bar on the first call. The lock must be
+// held by the caller.
+func (itr *Iterator) record() {
+	if itr.recorder == nil {
+		return
+	}
+
+	lines := make([]interface{}, 0, 2)
+	if !itr.recorded {
+		itr.recorded = true
+		lines = append(lines, recordHeader{
+			Version:                  RecordingVersion,
+			Start:                    itr.Values.GetStart(),
+			Unit:                     itr.Values.GetUnit(),
+			LineSize:                 itr.Settings.GetLineSize(),
+			LParen:                   itr.Settings.GetLParen(),
+			RParen:                   itr.Settings.GetRParen(),
+			FinishedIterationSymbol:  itr.Settings.GetFinishedIterationSymbol(),
+			CurrentIterationSymbol:   itr.Settings.GetCurrentIterationSymbol(),
+			RemainingIterationSymbol: itr.Settings.GetRemainingIterationSymbol(),
+		})
+	}
+
+	lines = append(lines, itr.snapshot())
+	for _, line := range lines {
+		data, err := json.Marshal(line)
+		if err == nil {
+			_, err = itr.recorder.Write(append(data, '\n'))
+		}
+
+		if err != nil {
+			itr.recorder = nil
+			return
+		}
+	}
+}
+
+// Replay draws a recorded progress bar, waiting between the updates as
+// long as when they were recorded. The progress bar can be altered using
+// the Set*() functions before Run, e.g. to draw to a different writer.
+type Replay struct {
+	*Iterator
+
+	Updates []Snapshot
+	header  recordHeader
+	clock   *replayClock
+	speed   float64
+}
+
+// replayClock is a render.Clock whose current time is set
+// from the recording, rather than read from the system clock.
+type replayClock struct {
+	*render.ClockVal
+}
+
+// Now leaves the current time as set from the recording
+func (c *replayClock) Now() {}
+
+// SetStartTime sets the start time to the start of the recording
+func (c *replayClock) SetStartTime() {
+	c.StartTime = time.Unix(0, 0)
+	c.CurrentTime = c.StartTime
+}
+
+// NewReplay reads a recording, as written using SetRecorder, creating a
+// Replay of it. The updates written using SetJSONOutput can also be
+// replayed, the default settings are used for them.
+func NewReplay(r io.Reader) (*Replay, error) {
+	rp := &Replay{Iterator: makeIteratorObject().(*Iterator), speed: 1.0}
+	rp.clock = &replayClock{ClockVal: new(render.ClockVal)}
+	rp.Clock = rp.clock
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for number := 1; scanner.Scan(); number++ {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+
+		if len(rp.Updates) == 0 && rp.header.Version == 0 {
+			var header recordHeader
+			if err := json.Unmarshal(line, &header); err == nil && header.Version != 0 {
+				if header.Version > RecordingVersion {
+					return nil, fmt.Errorf("Recording version: %d is not supported!", header.Version)
+				}
+
+				rp.header = header
+				continue
+			}
+		}
+
+		var s Snapshot
+		if err := json.Unmarshal(line, &s); err != nil {
+			return nil, fmt.Errorf("Line: %d of the recording is invalid: %v!", number, err)
+		}
+
+		rp.Updates = append(rp.Updates, s)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(rp.Updates) == 0 {
+		return nil, errors.New("The recording contains no updates!")
+	}
+
+	rp.createIteratorFromValues(rp.header.Start, rp.Updates[0].Total)
+	rp.SetUnit(rp.header.Unit)
+	if rp.header.Version != 0 {
+		rp.SetLParen(rp.header.LParen)
+		rp.SetRParen(rp.header.RParen)
+		rp.SetFinishedIterationSymbol(rp.header.FinishedIterationSymbol)
+		rp.SetCurrentIterationSymbol(rp.header.CurrentIterationSymbol)
+		rp.SetRemainingIterationSymbol(rp.header.RemainingIterationSymbol)
+	}
+
+	return rp, nil
+}
+
+// SetSpeed sets how quickly the recording is replayed, e.g. a speed
+// of 10 replays the recording ten times faster than it was recorded.
+// A speed of zero replays the recording without waiting.
+//
+// Default Value: 1
+func (rp *Replay) SetSpeed(speed float64) error {
+	if speed < 0.0 {
+		return fmt.Errorf("Speed: %f must not be negative!", speed)
+	}
+
+	rp.speed = speed
+
+	return nil
+}
+
+// Run replays the recording, returning once the last update has been
+// drawn or the progress bar has finished or been aborted.
+func (rp *Replay) Run() error {
+	// The recording is replayed at its recorded width, when it is known,
+	// the error is ignored as it would be from Initialize.
+	mutex := rp.lock()
+	rp.start()
+	if rp.header.LineSize > 0 {
+		rp.Settings.SetLineSize(rp.header.LineSize)
+	}
+	mutex.Unlock()
+
+	previous := time.Duration(0)
+	for _, s := range rp.Updates {
+		if rp.speed > 0.0 && s.Elapsed > previous {
+			time.Sleep(time.Duration(float64(s.Elapsed-previous) / rp.speed))
+		}
+
+		previous = s.Elapsed
+		if running, err := rp.show(s); err != nil || !running {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// show draws the update of the recording, reporting
+// whether the progress bar is still running.
+func (rp *Replay) show(s Snapshot) (bool, error) {
+	mutex := rp.lock()
+	defer rp.unlock(mutex)
+
+	if rp.state != Running {
+		return false, nil
+	}
+
+	rp.clock.CurrentTime = rp.clock.StartTime.Add(s.Elapsed)
+	rp.Settings.SetDescription(s.Description)
+	if s.Total >= rp.Values.GetStart() {
+		rp.Values.SetStop(s.Total)
+	}
+
+	if s.State == Aborted {
+		return false, rp.abort(errors.New("The recorded progress bar was aborted!"))
+	}
+
+	current := s.Current
+	if current < rp.Values.GetStart() {
+		current = rp.Values.GetStart()
+	}
+
+	err := rp.moveTo(current)
+
+	return rp.state == Running, err
+}
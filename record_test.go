@@ -0,0 +1,205 @@
+/*
+ * The MIT License
+ *
+ * Copyright 2018 kinsey40.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * File:   record_test.go
+ * Author: kinsey40
+ *
+ * Created on 19 October 2026, 22:40
+ *
+ * The test file for record.go
+ *
+ */
+
+package pbar_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/kinsey40/pbar"
+	"github.com/kinsey40/pbar/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecorder(t *testing.T) {
+	recording := new(bytes.Buffer)
+	itr := createJSONBar(t, new(bytes.Buffer), nil, 4)
+	itr.SetDescription("Work")
+	itr.SetUnit(render.UnitBytes)
+	itr.SetUnicode(false)
+	itr.SetRecorder(recording)
+	itr.Initialize()
+	itr.Update()
+	itr.Abort(errors.New("Failed!"))
+
+	lines := strings.Split(strings.TrimSpace(recording.String()), "\n")
+	expected := []string{
+		`{"pbar_recording":1,"start":0,"unit":1,"line_size":15,"lparen":"|","rparen":"|","finished":"#","current":"#","remaining":" "}`,
+		`{"desc":"Work","current":0,"total":4,"percent":0,"elapsed_ms":1000,"eta_ms":null,"rate":null,"state":"running"}`,
+		`{"desc":"Work","current":1,"total":4,"percent":25,"elapsed_ms":2000,"eta_ms":6000,"rate":0.5,"state":"running"}`,
+		`{"desc":"Work","current":1,"total":4,"percent":25,"elapsed_ms":3000,"eta_ms":9000,"rate":0.3333333333333333,"state":"aborted"}`,
+	}
+
+	assert.Equal(t, expected, lines, fmt.Sprintf("Recording expected: %v; got: %v", expected, lines))
+}
+
+func TestReplay(t *testing.T) {
+	testCases := []struct {
+		updates int
+		abort   bool
+	}{
+		{4, false},
+		{2, false},
+		{2, true},
+	}
+
+	for _, testCase := range testCases {
+		terminal := new(bytes.Buffer)
+		recording := new(bytes.Buffer)
+		itr := createJSONBar(t, terminal, nil, 4)
+		itr.SetDescription("Work")
+		itr.SetRemainingIterationSymbol(".")
+		itr.SetRecorder(recording)
+		itr.Initialize()
+		for index := 0; index < testCase.updates; index++ {
+			itr.Update()
+		}
+
+		if testCase.abort {
+			itr.Abort(errors.New("Failed!"))
+		}
+
+		replayed := new(bytes.Buffer)
+		rp, err := pbar.NewReplay(recording)
+		assert.NoError(t, err, fmt.Sprintf("Unexpected error(%v) raised reading the recording", err))
+
+		rp.Write = &render.Writing{W: replayed}
+		rp.SetSpeed(0)
+		err = rp.Run()
+
+		assert.NoError(t, err, fmt.Sprintf("Unexpected error(%v) raised by the replay", err))
+		assert.Equal(t, terminal.String(), replayed.String(), fmt.Sprintf("Replay expected: %q; got: %q", terminal.String(), replayed.String()))
+		assert.Equal(t, itr.Snapshot(), rp.Snapshot(), fmt.Sprintf("Snapshot expected: %+v; got: %+v", itr.Snapshot(), rp.Snapshot()))
+	}
+}
+
+func TestReplayJSONOutput(t *testing.T) {
+	output := new(bytes.Buffer)
+	itr := createJSONBar(t, new(bytes.Buffer), output, 2)
+	itr.Initialize()
+	itr.Update()
+	itr.Update()
+
+	rp, err := pbar.NewReplay(output)
+	assert.NoError(t, err, fmt.Sprintf("Unexpected error(%v) raised reading the JSON output", err))
+	assert.Len(t, rp.Updates, 3, fmt.Sprintf("Number of updates incorrect: %+v", rp.Updates))
+
+	replayed := new(bytes.Buffer)
+	rp.Write = &render.Writing{W: replayed}
+	rp.SetSpeed(0)
+	rp.Run()
+
+	s := rp.Snapshot()
+	assert.Equal(t, pbar.Finished, s.State, fmt.Sprintf("State expected: %v; got: %v", pbar.Finished, s.State))
+	assert.Contains(t, replayed.String(), "2.0/2.0 100.0%", fmt.Sprintf("Replay not finished: %q", replayed.String()))
+}
+
+func TestReplayWithoutTerminal(t *testing.T) {
+	testCases := []struct {
+		record           bool
+		expectedProgress string
+	}{
+		{true, "Work: |#######........| 2.0/4.0 50.0%"},
+		{false, "2.0/4.0 50.0%"},
+	}
+
+	for _, testCase := range testCases {
+		recording := new(bytes.Buffer)
+		itr := createJSONBar(t, new(bytes.Buffer), nil, 4)
+		itr.SetDescription("Work")
+		itr.SetRemainingIterationSymbol(".")
+		itr.SetUnicode(false)
+		if testCase.record {
+			itr.SetRecorder(recording)
+		} else {
+			itr.SetJSONOutput(recording)
+		}
+
+		itr.Initialize()
+		itr.Update()
+		itr.Update()
+		itr.Abort(nil)
+
+		render.TerminalSize = func(_ int) (int, int, error) { return 0, 0, errors.New("inappropriate ioctl for device") }
+		replayed := new(bytes.Buffer)
+		rp, _ := pbar.NewReplay(recording)
+		rp.Write = &render.Writing{W: replayed}
+		rp.SetSpeed(0)
+		err := rp.Run()
+
+		assert.NoError(t, err, fmt.Sprintf("Unexpected error(%v) raised by the replay", err))
+		assert.Contains(t, replayed.String(), testCase.expectedProgress, fmt.Sprintf("Output (%q) missing: %q", testCase.expectedProgress, replayed.String()))
+	}
+}
+
+func TestNewReplayErrors(t *testing.T) {
+	testCases := []string{
+		"",
+		"\n\n",
+		`{"pbar_recording":1,"start":0}`,
+		`{"pbar_recording":2,"start":0}` + "\n" + `{"current":1,"total":2,"state":"running"}`,
+		`{"current":1,"total":2,"state":"unknown"}`,
+		`{"current":1,"total":2,"state":"running"}` + "\nNot JSON",
+	}
+
+	for _, recording := range testCases {
+		rp, err := pbar.NewReplay(strings.NewReader(recording))
+		assert.Error(t, err, fmt.Sprintf("Expected error not raised for: %q", recording))
+		assert.Nil(t, rp, fmt.Sprintf("Replay created for: %q", recording))
+	}
+}
+
+func TestReplaySetSpeed(t *testing.T) {
+	testCases := []struct {
+		speed       float64
+		expectError bool
+	}{
+		{0.0, false},
+		{1.0, false},
+		{10.0, false},
+		{-1.0, true},
+	}
+
+	for _, testCase := range testCases {
+		rp, _ := pbar.NewReplay(strings.NewReader(`{"current":1,"total":2,"state":"running"}`))
+		err := rp.SetSpeed(testCase.speed)
+		if testCase.expectError {
+			assert.Error(t, err, fmt.Sprintf("Expected error not raised for speed: %v", testCase.speed))
+		} else {
+			assert.NoError(t, err, fmt.Sprintf("Unexpected error(%v) raised for speed: %v", err, testCase.speed))
+		}
+	}
+}
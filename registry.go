@@ -0,0 +1,244 @@
+/*
+ * The MIT License
+ *
+ * Copyright 2018 kinsey40.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * File:   registry.go
+ * Author: kinsey40
+ *
+ * Created on 19 October 2026, 17:00
+ *
+ * Registry keeps track of the progress bars which are running, so their
+ * progress can be exported (e.g. to Prometheus) whilst they run.
+ *
+ */
+
+package pbar
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// UnnamedBar is the name given, within a Registry, to a
+// progress bar without a description.
+var UnnamedBar = "pbar"
+
+// Registry tracks the progress bars which are running. A progress bar set
+// to use the Registry (see SetRegistry) is added when it is Initialized and,
+// once it finishes or is aborted, kept for the retention (see SetRetention)
+// so that its final state can be exported. A progress bar which is left
+// unfinished (e.g. by breaking out of the loop) should be Aborted.
+// The name of a progress bar within the Registry is its description, with
+// a number appended when several progress bars share a description,
+// e.g. "Files", "Files (2)".
+type Registry struct {
+	mutex     sync.Mutex
+	bars      []*Iterator
+	stopped   map[*Iterator]time.Time
+	retention time.Duration
+	watchers  []*watcher
+}
+
+// watcher collects the latest Snapshot of each progress bar updated since
+// the watcher was last read, signalling when there is a new Snapshot.
+type watcher struct {
+	signal    chan struct{}
+	snapshots map[string]Snapshot
+}
+
+// DefaultRegistry is the Registry exported when no Registry is given, e.g.
+// by NewHandler(nil). Progress bars are added to it using SetRegistry.
+var DefaultRegistry = NewRegistry()
+
+// DefaultRetention is how long a Registry keeps a progress bar once it has
+// finished or been aborted, long enough for it to be seen by a Prometheus
+// scrape or a poll of the expvar variables.
+var DefaultRetention = time.Minute * 5
+
+// NewRegistry creates an empty Registry
+func NewRegistry() *Registry {
+	return &Registry{stopped: make(map[*Iterator]time.Time), retention: DefaultRetention}
+}
+
+// SetRetention sets how long a progress bar is kept in the Registry once it
+// has finished or been aborted, a retention of zero removes it immediately.
+//
+// Default Value: DefaultRetention
+func (r *Registry) SetRetention(retention time.Duration) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.retention = retention
+	r.prune()
+}
+
+// SetRegistry sets the Registry the progress bar is added to when it is
+// Initialized, e.g. DefaultRegistry, so that it can be exported. A nil
+// Registry means the progress bar is not tracked. Children of the
+// progress bar are added to the same Registry.
+//
+// Default Value: nil (the progress bar is not tracked)
+func (itr *Iterator) SetRegistry(r *Registry) {
+	itr.registry = r
+}
+
+// Snapshots returns a Snapshot of each progress bar in
+// the Registry, keyed by the name of the progress bar.
+func (r *Registry) Snapshots() map[string]Snapshot {
+	r.mutex.Lock()
+	r.prune()
+	bars := make([]*Iterator, len(r.bars))
+	names := make([]string, len(r.bars))
+	for index, bar := range r.bars {
+		bars[index] = bar
+		names[index] = bar.registryName
+	}
+	r.mutex.Unlock()
+
+	snapshots := make(map[string]Snapshot, len(bars))
+	for index, bar := range bars {
+		snapshots[names[index]] = bar.Snapshot()
+	}
+
+	return snapshots
+}
+
+// add adds the progress bar to the Registry, if it is not already present,
+// naming it after its description. The lock of the progress bar must be
+// held by the caller.
+func (r *Registry) add(itr *Iterator) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.prune()
+	delete(r.stopped, itr)
+	taken := make(map[string]bool, len(r.bars))
+	for _, bar := range r.bars {
+		if bar == itr {
+			return
+		}
+		taken[bar.registryName] = true
+	}
+
+	name := strings.TrimSuffix(itr.Settings.GetDescription(), ":")
+	if name == "" {
+		name = UnnamedBar
+	}
+
+	itr.registryName = name
+	for n := 2; taken[itr.registryName]; n++ {
+		itr.registryName = fmt.Sprintf("%s (%d)", name, n)
+	}
+
+	r.bars = append(r.bars, itr)
+}
+
+// stop marks the progress bar as stopped, it is removed from
+// the Registry once the retention has passed.
+func (r *Registry) stop(itr *Iterator) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.stopped[itr] = time.Now()
+	r.prune()
+}
+
+// prune removes the progress bars which stopped at least the retention
+// ago. The lock of the Registry must be held by the caller.
+func (r *Registry) prune() {
+	now := time.Now()
+	bars := r.bars[:0]
+	for _, bar := range r.bars {
+		stopped, ok := r.stopped[bar]
+		if ok && now.Sub(stopped) >= r.retention {
+			delete(r.stopped, bar)
+			continue
+		}
+
+		bars = append(bars, bar)
+	}
+
+	r.bars = bars
+}
+
+// watch creates a watcher which is sent the updates of the progress bars
+func (r *Registry) watch() *watcher {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	w := &watcher{signal: make(chan struct{}, 1), snapshots: make(map[string]Snapshot)}
+	r.watchers = append(r.watchers, w)
+
+	return w
+}
+
+// unwatch stops the updates being sent to the watcher
+func (r *Registry) unwatch(w *watcher) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for index, other := range r.watchers {
+		if other == w {
+			r.watchers = append(r.watchers[:index], r.watchers[index+1:]...)
+			return
+		}
+	}
+}
+
+// updates returns the Snapshots sent to the watcher since it was last read
+func (r *Registry) updates(w *watcher) map[string]Snapshot {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	snapshots := w.snapshots
+	w.snapshots = make(map[string]Snapshot)
+
+	return snapshots
+}
+
+// publish sends a Snapshot of the progress bar to the watchers of its
+// Registry, including the final Snapshot once the progress bar has left
+// the Registry. The lock must be held by the caller.
+func (itr *Iterator) publish() {
+	r := itr.registry
+	if r == nil {
+		return
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if len(r.watchers) == 0 || itr.registryName == "" {
+		return
+	}
+
+	s := itr.snapshot()
+	for _, w := range r.watchers {
+		w.snapshots[itr.registryName] = s
+		select {
+		case w.signal <- struct{}{}:
+		default:
+		}
+	}
+}
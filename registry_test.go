@@ -0,0 +1,167 @@
+/*
+ * The MIT License
+ *
+ * Copyright 2018 kinsey40.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * File:   registry_test.go
+ * Author: kinsey40
+ *
+ * Created on 19 October 2026, 17:00
+ *
+ * The test file for registry.go
+ *
+ */
+
+package pbar_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/kinsey40/pbar"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegistry(t *testing.T) {
+	testCases := []struct {
+		retention      time.Duration
+		descriptions   []string
+		updates        []int
+		abort          []bool
+		expectedStates map[string]pbar.State
+	}{
+		{0, []string{"Work"}, []int{0}, []bool{false}, map[string]pbar.State{"Work": pbar.Running}},
+		{0, []string{"Work", "Work", ""}, []int{1, 1, 1}, []bool{false, false, false}, map[string]pbar.State{"Work": pbar.Running, "Work (2)": pbar.Running, pbar.UnnamedBar: pbar.Running}},
+		{0, []string{"Work", "Other"}, []int{2, 1}, []bool{false, false}, map[string]pbar.State{"Other": pbar.Running}},
+		{0, []string{"Work", "Other"}, []int{1, 1}, []bool{true, false}, map[string]pbar.State{"Other": pbar.Running}},
+		{time.Minute, []string{"Work", "Other"}, []int{2, 1}, []bool{false, false}, map[string]pbar.State{"Work": pbar.Finished, "Other": pbar.Running}},
+		{time.Minute, []string{"Work", "Other"}, []int{1, 1}, []bool{true, false}, map[string]pbar.State{"Work": pbar.Aborted, "Other": pbar.Running}},
+		{time.Minute, []string{"Work", "Work"}, []int{2, 1}, []bool{false, false}, map[string]pbar.State{"Work": pbar.Finished, "Work (2)": pbar.Running}},
+	}
+
+	for _, testCase := range testCases {
+		registry := pbar.NewRegistry()
+		registry.SetRetention(testCase.retention)
+		for index, description := range testCase.descriptions {
+			itr := createJSONBar(t, new(bytes.Buffer), nil, 2)
+			itr.SetRegistry(registry)
+			if description != "" {
+				itr.SetDescription(description)
+			}
+
+			itr.Initialize()
+			for update := 0; update < testCase.updates[index]; update++ {
+				itr.Update()
+			}
+
+			if testCase.abort[index] {
+				itr.Abort(errors.New("Failed!"))
+			}
+		}
+
+		states := make(map[string]pbar.State)
+		for name, s := range registry.Snapshots() {
+			states[name] = s.State
+		}
+
+		assert.Equal(t, testCase.expectedStates, states, fmt.Sprintf("States incorrect expected: %v; got: %v", testCase.expectedStates, states))
+	}
+}
+
+func TestRegistryRetention(t *testing.T) {
+	testCases := []struct {
+		retention time.Duration
+		wait      time.Duration
+		expected  int
+	}{
+		{pbar.DefaultRetention, 0, 1},
+		{time.Millisecond * 10, 0, 1},
+		{time.Millisecond * 10, time.Millisecond * 20, 0},
+		{0, 0, 0},
+	}
+
+	for _, testCase := range testCases {
+		registry := pbar.NewRegistry()
+		registry.SetRetention(testCase.retention)
+		itr := createJSONBar(t, new(bytes.Buffer), nil, 1)
+		itr.SetRegistry(registry)
+		itr.Initialize()
+		itr.Update()
+		time.Sleep(testCase.wait)
+
+		got := len(registry.Snapshots())
+		assert.Equal(t, testCase.expected, got, fmt.Sprintf("Progress bars retained expected: %v; got: %v for: %v after: %v", testCase.expected, got, testCase.retention, testCase.wait))
+	}
+}
+
+func TestSetRegistry(t *testing.T) {
+	registry := pbar.NewRegistry()
+	itr := createJSONBar(t, new(bytes.Buffer), nil, 2)
+	itr.SetRegistry(registry)
+	child, _ := itr.Child(2, "Child")
+	itr.Initialize()
+	child.Initialize()
+	assert.Len(t, registry.Snapshots(), 2, fmt.Sprintf("Child not added to the parents registry"))
+
+	other := createJSONBar(t, new(bytes.Buffer), new(bytes.Buffer), 2)
+	other.SetRegistry(nil)
+	other.Initialize()
+	assert.Len(t, registry.Snapshots(), 2, fmt.Sprintf("Progress bar without a registry was added"))
+}
+
+func TestDefaultRegistryOptIn(t *testing.T) {
+	testCases := []struct {
+		values   []interface{}
+		register bool
+		abort    bool
+		expected int
+	}{
+		{[]interface{}{0, 10, 3}, false, false, 0},
+		{[]interface{}{10}, false, false, 0},
+		{[]interface{}{10}, true, false, 1},
+		{[]interface{}{10}, true, true, 1},
+	}
+
+	for _, testCase := range testCases {
+		before := len(pbar.DefaultRegistry.Snapshots())
+		itr := createJSONBar(t, new(bytes.Buffer), nil, testCase.values...)
+		if testCase.register {
+			itr.SetRegistry(pbar.DefaultRegistry)
+		}
+
+		itr.Initialize()
+		for index := 0; index < 4; index++ {
+			itr.Update()
+		}
+
+		got := len(pbar.DefaultRegistry.Snapshots()) - before
+		if testCase.abort {
+			itr.Abort(nil)
+			got = len(pbar.DefaultRegistry.Snapshots()) - before
+		}
+
+		assert.Equal(t, testCase.expected, got, fmt.Sprintf("Bars added to the DefaultRegistry expected: %v; got: %v for: %v", testCase.expected, got, testCase.values))
+		itr.Abort(nil)
+	}
+}
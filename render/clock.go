@@ -49,11 +49,14 @@ type Clock interface {
 	Now()
 	Subtract() time.Duration
 	SetStartTime()
+	AddElapsed(time.Duration)
 	Start() time.Time
 	Seconds(time.Duration) float64
 	Remaining(float64) time.Duration
 	Format(time.Duration) string
 	IsStartTimeSet() error
+	Speed(float64, float64, float64) (time.Duration, time.Duration, float64, bool)
+	SetUnit(Unit)
 
 	CreateSpeedMeter(float64, float64, float64) string
 }
@@ -64,6 +67,7 @@ type Clock interface {
 type ClockVal struct {
 	StartTime   time.Time
 	CurrentTime time.Time
+	Unit        Unit
 }
 
 // NewClock returns an instance of a real-time clock.
@@ -100,6 +104,13 @@ func (c *ClockVal) SetStartTime() {
 	c.StartTime = NowTime()
 }
 
+// AddElapsed moves the StartTime back by the duration, so the elapsed
+// time includes time spent before the clock was started (e.g. by a
+// previous run of the program).
+func (c *ClockVal) AddElapsed(d time.Duration) {
+	c.StartTime = c.StartTime.Add(-d)
+}
+
 // Start returns the StartTime for the clock object
 func (c *ClockVal) Start() time.Time {
 	return c.StartTime
@@ -130,25 +141,41 @@ func (c *ClockVal) Format(d time.Duration) string {
 	return fmt.Sprintf("%02dh:%02dm:%02ds", hours, mins, secs)
 }
 
+// Speed returns the elapsed time, the time remaining and the rate of
+// iterations per second. The time remaining and the rate are only
+// known once the current value has moved beyond the start value.
+func (c *ClockVal) Speed(start, stop, current float64) (time.Duration, time.Duration, float64, bool) {
+	elapsed := c.Subtract()
+	if current <= start {
+		return elapsed, 0, 0, false
+	}
+
+	rate := (current - start) / elapsed.Seconds()
+	remainingTime := c.Remaining(math.Round((stop - current) / rate))
+
+	return elapsed, remainingTime, rate, true
+}
+
 // CreateSpeedMeter forms the part of the progress bar relating
 // to the elapsed and remaining time, as well as the rate of
 // iterations per second.
 func (c *ClockVal) CreateSpeedMeter(start, stop, current float64) string {
-	if current > start {
-		elapsed := c.Subtract()
-		rate := (current - start) / elapsed.Seconds()
-		remainingTime := c.Remaining(math.Round((stop - current) / rate))
-
-		return fmt.Sprintf("[elapsed: %s, left: %s, %.2f iters/sec]",
+	if elapsed, remainingTime, rate, known := c.Speed(start, stop, current); known {
+		return fmt.Sprintf("[elapsed: %s, left: %s, %s]",
 			c.Format(elapsed),
 			c.Format(remainingTime),
-			rate,
+			c.Unit.FormatRate(rate),
 		)
 	}
 
-	return fmt.Sprintf("[elapsed: %s, left: %s, %s iters/sec]",
+	return fmt.Sprintf("[elapsed: %s, left: %s, %s]",
 		"00m:00s",
 		"N/A",
-		"N/A",
+		c.Unit.FormatRate(-1.0),
 	)
 }
+
+// SetUnit sets the Unit in which the rate is displayed
+func (c *ClockVal) SetUnit(u Unit) {
+	c.Unit = u
+}
@@ -127,6 +127,30 @@ func TestSetStartTime(t *testing.T) {
 	}
 }
 
+func TestAddElapsed(t *testing.T) {
+	testCases := []struct {
+		startTime   time.Time
+		currentTime time.Time
+		elapsed     time.Duration
+		expected    time.Duration
+	}{
+		{time.Unix(10, 0), time.Unix(20, 0), 0, time.Second * 10},
+		{time.Unix(10, 0), time.Unix(20, 0), time.Minute, time.Second * 70},
+		{time.Unix(10, 0), time.Unix(10, 0), time.Hour * 8, time.Hour * 8},
+	}
+
+	for _, testCase := range testCases {
+		c := &render.ClockVal{
+			StartTime:   testCase.startTime,
+			CurrentTime: testCase.currentTime,
+		}
+		c.AddElapsed(testCase.elapsed)
+
+		message := fmt.Sprintf("Elapsed time incorrect expected: %v; got: %v", testCase.expected, c.Subtract())
+		assert.Equal(t, testCase.expected, c.Subtract(), message)
+	}
+}
+
 func TestSeconds(t *testing.T) {
 	testCases := []struct {
 		input          time.Duration
@@ -235,3 +259,34 @@ func TestCreateSpeedMeter(t *testing.T) {
 		)
 	}
 }
+
+func TestSpeed(t *testing.T) {
+	testCases := []struct {
+		start             float64
+		stop              float64
+		current           float64
+		elapsedSecs       int64
+		expectedElapsed   time.Duration
+		expectedRemaining time.Duration
+		expectedRate      float64
+		expectedKnown     bool
+	}{
+		{0.0, 5.0, 0.0, 3, time.Second * 3, 0, 0.0, false},
+		{0.0, 5.0, 1.0, 2, time.Second * 2, time.Second * 8, 0.5, true},
+		{2.0, 6.0, 4.0, 1, time.Second, time.Second, 2.0, true},
+	}
+
+	for _, testCase := range testCases {
+		c := render.ClockVal{
+			StartTime:   time.Unix(0, 0),
+			CurrentTime: time.Unix(testCase.elapsedSecs, 0),
+		}
+
+		elapsed, remaining, rate, known := c.Speed(testCase.start, testCase.stop, testCase.current)
+
+		assert.Equal(t, testCase.expectedElapsed, elapsed, fmt.Sprintf("Elapsed incorrect expected: %v; got: %v", testCase.expectedElapsed, elapsed))
+		assert.Equal(t, testCase.expectedRemaining, remaining, fmt.Sprintf("Remaining incorrect expected: %v; got: %v", testCase.expectedRemaining, remaining))
+		assert.Equal(t, testCase.expectedRate, rate, fmt.Sprintf("Rate incorrect expected: %v; got: %v", testCase.expectedRate, rate))
+		assert.Equal(t, testCase.expectedKnown, known, fmt.Sprintf("Known incorrect expected: %v; got: %v", testCase.expectedKnown, known))
+	}
+}
@@ -0,0 +1,64 @@
+/*
+ * The MIT License
+ *
+ * Copyright 2018 kinsey40.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * File:   encoding.go
+ * Author: kinsey40
+ *
+ * Created on 19 October 2026, 16:02
+ *
+ * Encoding examines the environment to determine whether the terminal is able
+ * to display Unicode, enabling the default symbols to be swapped for their
+ * ASCII equivalents on terminals which cannot.
+ *
+ */
+
+package render
+
+import "strings"
+
+// ASCIIPreset holds the ASCII equivalents of the default symbols
+var ASCIIPreset = Preset{"#", "#", " ", "|", "|"}
+
+// SupportsUnicode examines the TERM, LC_ALL, LC_CTYPE and LANG environment
+// variables to determine if the terminal can display Unicode. A "dumb"
+// terminal, or a locale (the first set of LC_ALL, LC_CTYPE and LANG)
+// which is not UTF-8, is assumed to support ASCII only. When no locale
+// is set (e.g. on Windows) Unicode is assumed to be supported.
+func SupportsUnicode() bool {
+	if term, _ := LookupEnv("TERM"); term == "dumb" {
+		return false
+	}
+
+	for _, key := range []string{"LC_ALL", "LC_CTYPE", "LANG"} {
+		locale, _ := LookupEnv(key)
+		if locale == "" {
+			continue
+		}
+
+		locale = strings.ToUpper(locale)
+
+		return strings.Contains(locale, "UTF-8") || strings.Contains(locale, "UTF8")
+	}
+
+	return true
+}
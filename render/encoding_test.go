@@ -0,0 +1,77 @@
+/*
+ * The MIT License
+ *
+ * Copyright 2018 kinsey40.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * File:   encoding_test.go
+ * Author: kinsey40
+ *
+ * Created on 19 October 2026, 16:02
+ *
+ * The test file for encoding.go
+ *
+ */
+
+package render_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/kinsey40/pbar/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSupportsUnicode(t *testing.T) {
+	testCases := []struct {
+		env            map[string]string
+		expectedResult bool
+	}{
+		{map[string]string{"LANG": "en_GB.UTF-8"}, true},
+		{map[string]string{"LANG": "en_US.utf8"}, true},
+		{map[string]string{"LC_ALL": "C.UTF-8", "LANG": "C"}, true},
+		{map[string]string{"LC_ALL": "C", "LANG": "en_GB.UTF-8"}, false},
+		{map[string]string{"LC_CTYPE": "en_GB.ISO-8859-1", "LANG": "en_GB.UTF-8"}, false},
+		{map[string]string{"LC_ALL": "", "LANG": "en_GB.UTF-8"}, true},
+		{map[string]string{"LANG": "POSIX"}, false},
+		{map[string]string{}, true},
+		{map[string]string{"TERM": "xterm", "LC_ALL": ""}, true},
+		{map[string]string{"TERM": "dumb"}, false},
+		{map[string]string{"TERM": "dumb", "LANG": "en_GB.UTF-8"}, false},
+		{map[string]string{"TERM": "xterm-256color", "LANG": "en_GB.UTF-8"}, true},
+	}
+
+	for _, testCase := range testCases {
+		env := testCase.env
+		render.LookupEnv = func(key string) (string, bool) {
+			value, ok := env[key]
+			return value, ok
+		}
+
+		result := render.SupportsUnicode()
+		message := fmt.Sprintf("Unicode support incorrect expected: %v; got: %v for env: %v", testCase.expectedResult, result, testCase.env)
+
+		assert.Equal(t, testCase.expectedResult, result, message)
+	}
+
+	render.LookupEnv = os.LookupEnv
+}
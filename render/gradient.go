@@ -0,0 +1,123 @@
+/*
+ * The MIT License
+ *
+ * Copyright 2018 kinsey40.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * File:   gradient.go
+ * Author: kinsey40
+ *
+ * Created on 19 October 2026, 14:37
+ *
+ * Gradient enables the colour of the progress bar to change as progress is
+ * made, either smoothly across a set of colours or in steps at percentage
+ * thresholds. A status colour (warning or error) overrides both.
+ *
+ */
+
+package render
+
+import "math"
+
+// Status is the state of the progress bar, which can be set whilst the
+// progress bar is running to highlight problems.
+type Status int
+
+// The supported status values, StatusNormal uses the theme colours
+const (
+	StatusNormal Status = iota
+	StatusWarning
+	StatusError
+)
+
+// The colours used for the warning and error status, if they are
+// not set by the theme
+var (
+	DefaultWarningColour = Yellow
+	DefaultErrorColour   = Red
+)
+
+// Gradient holds a set of colours, evenly spaced across the progress bar,
+// between which the bar colour is blended.
+type Gradient []Colour
+
+// TrafficLightGradient blends from red, through yellow, to green
+var TrafficLightGradient = Gradient{RGB(215, 0, 0), RGB(215, 215, 0), RGB(0, 175, 0)}
+
+// Threshold sets the colour of the progress bar once the
+// percentage completed reaches the Percentage value.
+type Threshold struct {
+	Percentage float64
+	Colour     Colour
+}
+
+// At returns the colour at the given fraction (0 to 1) along the gradient
+func (g Gradient) At(fraction float64) Colour {
+	switch len(g) {
+	case 0:
+		return Colour{}
+	case 1:
+		return g[0]
+	}
+
+	fraction = math.Max(0.0, math.Min(1.0, fraction))
+	position := fraction * float64(len(g)-1)
+	index := int(position)
+	if index >= len(g)-1 {
+		return g[len(g)-1]
+	}
+
+	from, to := g[index].rgb(), g[index+1].rgb()
+	blend := position - float64(index)
+	mix := func(a, b uint8) uint8 {
+		return uint8(math.Round(float64(a) + (float64(b)-float64(a))*blend))
+	}
+
+	return RGB(mix(from[0], to[0]), mix(from[1], to[1]), mix(from[2], to[2]))
+}
+
+// rgb returns the (approximate) RGB values of the colour
+func (c Colour) rgb() [3]uint8 {
+	switch c.Type {
+	case BasicColour:
+		return basicPalette[c.Index]
+	case IndexedColour:
+		return indexedToRGB(c.Index)
+	case RGBColour:
+		return [3]uint8{c.R, c.G, c.B}
+	}
+
+	return basicPalette[7]
+}
+
+// thresholdColour returns the colour of the highest threshold which has
+// been reached by the percentage.
+func thresholdColour(thresholds []Threshold, percentage float64) (Colour, bool) {
+	var colour Colour
+	found := false
+	highest := math.Inf(-1)
+	for _, threshold := range thresholds {
+		if percentage >= threshold.Percentage && threshold.Percentage > highest {
+			colour, highest, found = threshold.Colour, threshold.Percentage, true
+		}
+	}
+
+	return colour, found
+}
@@ -0,0 +1,119 @@
+/*
+ * The MIT License
+ *
+ * Copyright 2018 kinsey40.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * File:   gradient_test.go
+ * Author: kinsey40
+ *
+ * Created on 19 October 2026, 14:37
+ *
+ * The test file for gradient.go
+ *
+ */
+
+package render_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kinsey40/pbar/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGradientAt(t *testing.T) {
+	testCases := []struct {
+		gradient       render.Gradient
+		fraction       float64
+		expectedColour render.Colour
+	}{
+		{render.Gradient{}, 0.5, render.Colour{}},
+		{render.Gradient{render.Red}, 0.5, render.Red},
+		{render.Gradient{render.RGB(0, 0, 0), render.RGB(200, 100, 50)}, 0.0, render.RGB(0, 0, 0)},
+		{render.Gradient{render.RGB(0, 0, 0), render.RGB(200, 100, 50)}, 0.5, render.RGB(100, 50, 25)},
+		{render.Gradient{render.RGB(0, 0, 0), render.RGB(200, 100, 50)}, 1.0, render.RGB(200, 100, 50)},
+		{render.Gradient{render.RGB(0, 0, 0), render.RGB(200, 100, 50)}, 2.0, render.RGB(200, 100, 50)},
+		{render.Gradient{render.RGB(0, 0, 0), render.RGB(200, 100, 50)}, -1.0, render.RGB(0, 0, 0)},
+		{render.TrafficLightGradient, 0.5, render.RGB(215, 215, 0)},
+		{render.TrafficLightGradient, 0.75, render.RGB(108, 195, 0)},
+		{render.Gradient{render.Black, render.Indexed(231)}, 0.5, render.RGB(128, 128, 128)},
+	}
+
+	for _, testCase := range testCases {
+		colour := testCase.gradient.At(testCase.fraction)
+		message := fmt.Sprintf("Gradient colour incorrect expected: %v; got: %v at: %v", testCase.expectedColour, colour, testCase.fraction)
+
+		assert.Equal(t, testCase.expectedColour, colour, message)
+	}
+}
+
+func TestCreateBarStringWithProgressColour(t *testing.T) {
+	thresholds := []render.Threshold{
+		{Percentage: 50.0, Colour: render.Yellow},
+		{Percentage: 0.0, Colour: render.Red},
+		{Percentage: 90.0, Colour: render.Green},
+	}
+
+	testCases := []struct {
+		numStepsCompleted int
+		percentage        float64
+		gradient          render.Gradient
+		thresholds        []render.Threshold
+		status            render.Status
+		theme             render.Theme
+		expectedOutput    string
+	}{
+		{2, 50.0, nil, nil, render.StatusNormal, render.Theme{}, "|=>--|"},
+		{2, 25.0, nil, thresholds, render.StatusNormal, render.Theme{}, "|\033[31m=\033[0m\033[31m>\033[0m--|"},
+		{2, 50.0, nil, thresholds, render.StatusNormal, render.Theme{}, "|\033[33m=\033[0m\033[33m>\033[0m--|"},
+		{4, 100.0, nil, thresholds, render.StatusNormal, render.Theme{}, "|\033[32m===\033[0m\033[32m>\033[0m|"},
+		{2, 50.0, nil, thresholds, render.StatusWarning, render.Theme{}, "|\033[33m=\033[0m\033[33m>\033[0m--|"},
+		{2, 50.0, nil, thresholds, render.StatusError, render.Theme{}, "|\033[31m=\033[0m\033[31m>\033[0m--|"},
+		{2, 50.0, nil, nil, render.StatusError, render.Theme{Error: render.Style{Foreground: render.Magenta}}, "|\033[35m=\033[0m\033[35m>\033[0m--|"},
+		{2, 50.0, nil, nil, render.StatusWarning, render.Theme{Warning: render.Style{Foreground: render.Cyan}}, "|\033[36m=\033[0m\033[36m>\033[0m--|"},
+		{2, 50.0, render.Gradient{render.Red, render.Red}, nil, render.StatusNormal, render.Theme{}, "|\033[31m=\033[0m\033[31m>\033[0m--|"},
+		{3, 75.0, render.Gradient{render.Red, render.Blue}, nil, render.StatusNormal, render.Theme{Finished: render.Style{Bold: true}}, "|\033[1;31m=\033[0m\033[1;31m=\033[0m\033[34m>\033[0m-|"},
+		{2, 50.0, render.Gradient{render.Red, render.Blue}, nil, render.StatusError, render.Theme{}, "|\033[31m=\033[0m\033[31m>\033[0m--|"},
+	}
+
+	for _, testCase := range testCases {
+		s := &render.Set{
+			FinishedIterationSymbol:  "=",
+			CurrentIterationSymbol:   ">",
+			RemainingIterationSymbol: "-",
+			LineSize:                 4,
+			LParen:                   "|",
+			RParen:                   "|",
+			Theme:                    testCase.theme,
+			ColourMode:               render.Colour16,
+			Gradient:                 testCase.gradient,
+			Thresholds:               testCase.thresholds,
+			Status:                   testCase.status,
+			Percentage:               testCase.percentage,
+		}
+
+		output := s.CreateBarString(testCase.numStepsCompleted)
+		message := fmt.Sprintf("Output incorrect expected: %q; got: %q", testCase.expectedOutput, output)
+
+		assert.Equal(t, testCase.expectedOutput, output, message)
+	}
+}
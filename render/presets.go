@@ -0,0 +1,122 @@
+/*
+ * The MIT License
+ *
+ * Copyright 2018 kinsey40.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * File:   presets.go
+ * Author: kinsey40
+ *
+ * Created on 19 October 2026, 11:20
+ *
+ * Presets holds named sets of symbols used to draw the progress bar, enabling
+ * the style of the bar to be selected with a single call. Users can register
+ * their own presets for reuse.
+ *
+ */
+
+package render
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// Preset holds the symbols used to draw the progress bar
+type Preset struct {
+	FinishedIterationSymbol  string
+	CurrentIterationSymbol   string
+	RemainingIterationSymbol string
+	LParen                   string
+	RParen                   string
+}
+
+// The built-in presets
+var (
+	DefaultPreset = Preset{DefaultFinishedIterationSymbol, DefaultCurrentIterationSymbol, DefaultRemainingIterationSymbol, DefaultLParen, DefaultRParen}
+	ClassicPreset = Preset{"=", ">", " ", "[", "]"}
+	BlocksPreset  = Preset{"\u2588", "\u2593", "\u2591", "\u2595", "\u258f"}
+	DotsPreset    = Preset{"\u25cf", "\u25cf", "\u00b7", "", ""}
+	ArrowsPreset  = Preset{"\u25b6", "\u25b6", "\u25b7", "", ""}
+	PipPreset     = Preset{"\u2501", "\u2578", "\u2500", "", ""}
+	BraillePreset = Preset{"\u28ff", "\u28f7", "\u28c0", "\u2847", "\u28b8"}
+)
+
+var (
+	presetsMutex sync.RWMutex
+	presets      = map[string]Preset{
+		"default": DefaultPreset,
+		"classic": ClassicPreset,
+		"blocks":  BlocksPreset,
+		"dots":    DotsPreset,
+		"arrows":  ArrowsPreset,
+		"pip":     PipPreset,
+		"braille": BraillePreset,
+		"ascii":   ASCIIPreset,
+	}
+)
+
+// RegisterPreset adds a preset under the given name, so that it can be
+// selected by name. An error is returned if the name is already in use.
+func RegisterPreset(name string, preset Preset) error {
+	if name == "" {
+		return errors.New("Preset name must not be empty!")
+	}
+
+	presetsMutex.Lock()
+	defer presetsMutex.Unlock()
+
+	if _, ok := presets[name]; ok {
+		return fmt.Errorf("Preset: %q is already registered!", name)
+	}
+
+	presets[name] = preset
+
+	return nil
+}
+
+// LookupPreset returns the preset registered under the given name
+func LookupPreset(name string) (Preset, error) {
+	presetsMutex.RLock()
+	defer presetsMutex.RUnlock()
+
+	preset, ok := presets[name]
+	if !ok {
+		return Preset{}, fmt.Errorf("Preset: %q does not exist!", name)
+	}
+
+	return preset, nil
+}
+
+// PresetNames returns the names of all registered presets, in order
+func PresetNames() []string {
+	presetsMutex.RLock()
+	defer presetsMutex.RUnlock()
+
+	names := make([]string, 0, len(presets))
+	for name := range presets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
@@ -0,0 +1,108 @@
+/*
+ * The MIT License
+ *
+ * Copyright 2018 kinsey40.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * File:   presets_test.go
+ * Author: kinsey40
+ *
+ * Created on 19 October 2026, 11:20
+ *
+ * The test file for presets.go
+ *
+ */
+
+package render_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/kinsey40/pbar/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegisterPreset(t *testing.T) {
+	// Presets cannot be unregistered, so the name is unique to each run
+	name := fmt.Sprintf("registerTest%d", time.Now().UnixNano())
+	testCases := []struct {
+		name        string
+		preset      render.Preset
+		expectError bool
+	}{
+		{name, render.Preset{"+", "+", ".", "(", ")"}, false},
+		{name, render.Preset{"*", "*", ".", "(", ")"}, true},
+		{"classic", render.Preset{"*", "*", ".", "(", ")"}, true},
+		{"", render.Preset{"*", "*", ".", "(", ")"}, true},
+	}
+
+	for _, testCase := range testCases {
+		err := render.RegisterPreset(testCase.name, testCase.preset)
+		if testCase.expectError {
+			assert.Error(t, err, fmt.Sprintf("Expected error not raised for: %q", testCase.name))
+		} else {
+			assert.NoError(t, err, fmt.Sprintf("Unexpected error raised: %v", err))
+
+			preset, err := render.LookupPreset(testCase.name)
+			assert.NoError(t, err, fmt.Sprintf("Unexpected error raised: %v", err))
+			assert.Equal(t, testCase.preset, preset, fmt.Sprintf("Preset incorrect expected: %v; got: %v", testCase.preset, preset))
+		}
+	}
+}
+
+func TestLookupPreset(t *testing.T) {
+	testCases := []struct {
+		name           string
+		expectedPreset render.Preset
+		expectError    bool
+	}{
+		{"default", render.DefaultPreset, false},
+		{"classic", render.ClassicPreset, false},
+		{"blocks", render.BlocksPreset, false},
+		{"dots", render.DotsPreset, false},
+		{"arrows", render.ArrowsPreset, false},
+		{"pip", render.PipPreset, false},
+		{"braille", render.BraillePreset, false},
+		{"Hello", render.Preset{}, true},
+	}
+
+	for _, testCase := range testCases {
+		preset, err := render.LookupPreset(testCase.name)
+		if testCase.expectError {
+			assert.Error(t, err, fmt.Sprintf("Expected error not raised"))
+		} else {
+			assert.NoError(t, err, fmt.Sprintf("Unexpected error raised: %v", err))
+		}
+
+		message := fmt.Sprintf("Preset incorrect expected: %v; got: %v", testCase.expectedPreset, preset)
+		assert.Equal(t, testCase.expectedPreset, preset, message)
+	}
+}
+
+func TestPresetNames(t *testing.T) {
+	names := render.PresetNames()
+	for _, name := range []string{"arrows", "blocks", "braille", "classic", "default", "dots", "pip"} {
+		assert.Contains(t, names, name, fmt.Sprintf("Preset: %v missing from names: %v", name, names))
+	}
+
+	assert.IsIncreasing(t, names, fmt.Sprintf("Preset names are not sorted: %v", names))
+}
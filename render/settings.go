@@ -79,6 +79,15 @@ type Settings interface {
 	SetLParen(string)
 	SetRParen(string)
 	SetSuffix(string)
+	SetTheme(Theme)
+	SetColourMode(ColourMode)
+	SetGradient(Gradient)
+	SetThresholds([]Threshold)
+	SetStatus(Status)
+	SetPercentage(float64)
+	SetPreset(Preset)
+	SetUnicode(bool)
+	SetTaskbar(bool)
 	SetIdealLineSize() error
 
 	GetDescription() string
@@ -90,7 +99,16 @@ type Settings interface {
 	GetLParen() string
 	GetRParen() string
 	GetSuffix() string
-
+	GetTheme() Theme
+	GetColourMode() ColourMode
+	GetGradient() Gradient
+	GetThresholds() []Threshold
+	GetStatus() Status
+	GetPercentage() float64
+	GetPreset() Preset
+	GetTaskbar() bool
+
+	Paint(Segment, string) string
 	CreateBarString(int) string
 }
 
@@ -105,6 +123,13 @@ type Set struct {
 	LParen                   string
 	RParen                   string
 	Suffix                   string
+	Theme                    Theme
+	ColourMode               ColourMode
+	Gradient                 Gradient
+	Thresholds               []Threshold
+	Status                   Status
+	Percentage               float64
+	Taskbar                  bool
 }
 
 // NewSettings creates a Settings interface
@@ -119,6 +144,9 @@ func NewSettings() Settings {
 	s.LParen = DefaultLParen
 	s.RParen = DefaultRParen
 	s.Suffix = DefaultSuffix
+	s.Theme = DefaultTheme
+	s.ColourMode = DefaultColourMode
+	s.SetUnicode(SupportsUnicode())
 
 	return s
 }
@@ -147,10 +175,13 @@ func (s *Set) SetRemainingIterationSymbol(str string) {
 	s.RemainingIterationSymbol = str
 }
 
-// SetLineSize sets the LineSize value
+// SetLineSize sets the LineSize value, which is at least zero
+// and at most the MaxLineSize.
 func (s *Set) SetLineSize(i int) {
 	if i > s.MaxLineSize {
 		s.LineSize = s.MaxLineSize
+	} else if i < 0 {
+		s.LineSize = 0
 	} else {
 		s.LineSize = i
 	}
@@ -182,6 +213,68 @@ func (s *Set) SetSuffix(value string) {
 	}
 }
 
+// SetTheme sets the Theme value
+func (s *Set) SetTheme(theme Theme) {
+	s.Theme = theme
+}
+
+// SetColourMode sets the ColourMode value
+func (s *Set) SetColourMode(mode ColourMode) {
+	s.ColourMode = mode
+}
+
+// SetGradient sets the Gradient value
+func (s *Set) SetGradient(gradient Gradient) {
+	s.Gradient = gradient
+}
+
+// SetThresholds sets the Thresholds value
+func (s *Set) SetThresholds(thresholds []Threshold) {
+	s.Thresholds = thresholds
+}
+
+// SetStatus sets the Status value
+func (s *Set) SetStatus(status Status) {
+	s.Status = status
+}
+
+// SetPercentage sets the Percentage value
+func (s *Set) SetPercentage(percentage float64) {
+	s.Percentage = percentage
+}
+
+// SetPreset sets the symbols used to draw the bar from the Preset
+func (s *Set) SetPreset(preset Preset) {
+	s.FinishedIterationSymbol = preset.FinishedIterationSymbol
+	s.CurrentIterationSymbol = preset.CurrentIterationSymbol
+	s.RemainingIterationSymbol = preset.RemainingIterationSymbol
+	s.LParen = preset.LParen
+	s.RParen = preset.RParen
+}
+
+// SetUnicode swaps the default symbols for their ASCII equivalents (false),
+// or back again (true). Symbols which have been changed from the defaults
+// are left untouched.
+func (s *Set) SetUnicode(enabled bool) {
+	from := Preset{DefaultFinishedIterationSymbol, DefaultCurrentIterationSymbol, DefaultRemainingIterationSymbol, DefaultLParen, DefaultRParen}
+	to := ASCIIPreset
+	if enabled {
+		from, to = to, from
+	}
+
+	swap := func(symbol *string, from, to string) {
+		if *symbol == from {
+			*symbol = to
+		}
+	}
+
+	swap(&s.FinishedIterationSymbol, from.FinishedIterationSymbol, to.FinishedIterationSymbol)
+	swap(&s.CurrentIterationSymbol, from.CurrentIterationSymbol, to.CurrentIterationSymbol)
+	swap(&s.RemainingIterationSymbol, from.RemainingIterationSymbol, to.RemainingIterationSymbol)
+	swap(&s.LParen, from.LParen, to.LParen)
+	swap(&s.RParen, from.RParen, to.RParen)
+}
+
 // SetIdealLineSize sets the line size to be almost the same size as the current terminal
 func (s *Set) SetIdealLineSize() error {
 	width, _, err := TerminalSize(int(GetTerminal()))
@@ -190,6 +283,10 @@ func (s *Set) SetIdealLineSize() error {
 	}
 
 	idealLength := width - len(s.Description) - len(s.RParen) - len(s.LParen) - NumberOfCharacters - NumberOfCharactersBuffer
+	if idealLength < 0 {
+		idealLength = 0
+	}
+
 	s.LineSize = idealLength
 
 	return nil
@@ -240,31 +337,127 @@ func (s *Set) GetSuffix() string {
 	return s.Suffix
 }
 
-// CreateBarString creates the actual 'bar' within the progress bar
+// GetTheme gets the Theme value
+func (s *Set) GetTheme() Theme {
+	return s.Theme
+}
+
+// GetColourMode gets the ColourMode value
+func (s *Set) GetColourMode() ColourMode {
+	return s.ColourMode
+}
+
+// GetGradient gets the Gradient value
+func (s *Set) GetGradient() Gradient {
+	return s.Gradient
+}
+
+// GetThresholds gets the Thresholds value
+func (s *Set) GetThresholds() []Threshold {
+	return s.Thresholds
+}
+
+// GetStatus gets the Status value
+func (s *Set) GetStatus() Status {
+	return s.Status
+}
+
+// GetPercentage gets the Percentage value
+func (s *Set) GetPercentage() float64 {
+	return s.Percentage
+}
+
+// GetPreset gets the symbols used to draw the bar as a Preset
+func (s *Set) GetPreset() Preset {
+	return Preset{
+		FinishedIterationSymbol:  s.FinishedIterationSymbol,
+		CurrentIterationSymbol:   s.CurrentIterationSymbol,
+		RemainingIterationSymbol: s.RemainingIterationSymbol,
+		LParen:                   s.LParen,
+		RParen:                   s.RParen,
+	}
+}
+
+// Paint applies the style of the segment (from the Theme) to the string
+func (s *Set) Paint(segment Segment, str string) string {
+	return s.Theme.Style(segment).Apply(str, s.ColourMode)
+}
+
+// CreateBarString creates the actual 'bar' within the progress bar,
+// preceded by the taskbar escape sequence if Taskbar is true.
 func (s *Set) CreateBarString(numStepsCompleted int) string {
-	var finString string
-	var currString string
-	var remString string
+	var finCount int
+	var currCount int
+	var remCount int
 
 	switch numStepsCompleted {
 	case 0:
-		remString = strings.Repeat(s.RemainingIterationSymbol, s.LineSize)
+		remCount = s.LineSize
 	case 1:
-		currString = s.CurrentIterationSymbol
-		remString = strings.Repeat(s.RemainingIterationSymbol, s.LineSize-1)
+		currCount = 1
+		remCount = s.LineSize - 1
 	case s.LineSize:
-		finString = strings.Repeat(s.FinishedIterationSymbol, s.LineSize-1)
-		currString = s.CurrentIterationSymbol
+		finCount = s.LineSize - 1
+		currCount = 1
 	default:
-		finString = strings.Repeat(s.FinishedIterationSymbol, numStepsCompleted-1)
-		currString = s.CurrentIterationSymbol
-		remString = strings.Repeat(s.RemainingIterationSymbol, s.LineSize-numStepsCompleted)
+		finCount = numStepsCompleted - 1
+		currCount = 1
+		remCount = s.LineSize - numStepsCompleted
 	}
 
-	barString := fmt.Sprintf("%s%s%s%s%s", s.LParen, finString, currString, remString, s.RParen)
+	barString := fmt.Sprintf("%s%s%s%s%s",
+		s.Paint(ParensSegment, s.LParen),
+		s.paintProgress(FinishedSegment, s.FinishedIterationSymbol, 0, finCount),
+		s.paintProgress(CurrentSegment, s.CurrentIterationSymbol, finCount, currCount),
+		s.Paint(RemainingSegment, strings.Repeat(s.RemainingIterationSymbol, remCount)),
+		s.Paint(ParensSegment, s.RParen),
+	)
+
 	if s.Description != DefaultDescription {
-		barString = strings.Join([]string{s.Description, barString}, " ")
+		barString = strings.Join([]string{s.Paint(DescriptionSegment, s.Description), barString}, " ")
+	}
+
+	return s.taskbarSequence() + barString
+}
+
+// paintProgress styles the completed section of the bar. The foreground
+// colour of the theme is replaced by the status colour, the threshold colour
+// or the gradient (in that order of preference), if any are set.
+func (s *Set) paintProgress(segment Segment, symbol string, offset, count int) string {
+	style := s.Theme.Style(segment)
+	if colour, ok := s.progressColour(); ok {
+		style.Foreground = colour
+		return style.Apply(strings.Repeat(symbol, count), s.ColourMode)
+	}
+
+	if len(s.Gradient) == 0 || s.LineSize <= 1 {
+		return style.Apply(strings.Repeat(symbol, count), s.ColourMode)
+	}
+
+	cells := make([]string, 0, count)
+	for cell := offset; cell < offset+count; cell++ {
+		style.Foreground = s.Gradient.At(float64(cell) / float64(s.LineSize-1))
+		cells = append(cells, style.Apply(symbol, s.ColourMode))
+	}
+
+	return strings.Join(cells, "")
+}
+
+// progressColour returns the colour overriding the theme for the
+// completed section of the bar, if any.
+func (s *Set) progressColour() (Colour, bool) {
+	switch s.Status {
+	case StatusWarning:
+		if colour := s.Theme.Warning.Foreground; !colour.IsDefault() {
+			return colour, true
+		}
+		return DefaultWarningColour, true
+	case StatusError:
+		if colour := s.Theme.Error.Foreground; !colour.IsDefault() {
+			return colour, true
+		}
+		return DefaultErrorColour, true
 	}
 
-	return barString
+	return thresholdColour(s.Thresholds, s.Percentage)
 }
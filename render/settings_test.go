@@ -36,6 +36,7 @@ package render_test
 import (
 	"errors"
 	"fmt"
+	"os"
 	"testing"
 
 	"github.com/kinsey40/pbar/render"
@@ -49,6 +50,14 @@ type MockTerminal struct {
 }
 
 func TestNewSettings(t *testing.T) {
+	render.LookupEnv = func(key string) (string, bool) {
+		if key == "LANG" {
+			return "en_GB.UTF-8", true
+		}
+		return "", false
+	}
+	defer func() { render.LookupEnv = os.LookupEnv }()
+
 	s := render.NewSettings()
 	set := s.(*render.Set)
 
@@ -109,6 +118,29 @@ func TestNewSettings(t *testing.T) {
 		set.MaxLineSize,
 		fmt.Sprintf("MaxLineSize incorred expected: %v; got: %v", render.DefaultMaxLineSize, set.MaxLineSize),
 	)
+
+	assert.Equal(
+		t,
+		render.DefaultColourMode,
+		set.ColourMode,
+		fmt.Sprintf("ColourMode incorrect expected: %v; got: %v", render.DefaultColourMode, set.ColourMode),
+	)
+}
+
+func TestNewSettingsWithoutUnicode(t *testing.T) {
+	render.LookupEnv = func(key string) (string, bool) {
+		if key == "LANG" {
+			return "C", true
+		}
+		return "", false
+	}
+	defer func() { render.LookupEnv = os.LookupEnv }()
+
+	s := render.NewSettings()
+	output := s.(*render.Set).GetPreset()
+	message := fmt.Sprintf("Symbols incorrect expected: %v; got: %v", render.ASCIIPreset, output)
+
+	assert.Equal(t, render.ASCIIPreset, output, message)
 }
 
 func TestSetDescription(t *testing.T) {
@@ -188,6 +220,8 @@ func TestSetLineSize(t *testing.T) {
 	}{
 		{5, 10, 5},
 		{10, 5, 5},
+		{0, 10, 0},
+		{-5, 10, 0},
 	}
 
 	for _, testCase := range testCases {
@@ -445,6 +479,292 @@ func TestGetSuffix(t *testing.T) {
 	}
 }
 
+func TestSetTheme(t *testing.T) {
+	testCases := []struct {
+		input render.Theme
+	}{
+		{render.Themes["classic"]},
+		{render.DefaultTheme},
+	}
+
+	for _, testCase := range testCases {
+		s := &render.Set{}
+		s.SetTheme(testCase.input)
+		message := fmt.Sprintf("Theme incorrectly set expected: %v; got %v", testCase.input, s.Theme)
+
+		assert.Equal(t, testCase.input, s.Theme, message)
+	}
+}
+
+func TestSetColourMode(t *testing.T) {
+	testCases := []struct {
+		input render.ColourMode
+	}{
+		{render.ColourNone},
+		{render.Colour256},
+	}
+
+	for _, testCase := range testCases {
+		s := &render.Set{}
+		s.SetColourMode(testCase.input)
+		message := fmt.Sprintf("ColourMode incorrectly set expected: %v; got %v", testCase.input, s.ColourMode)
+
+		assert.Equal(t, testCase.input, s.ColourMode, message)
+	}
+}
+
+func TestGetTheme(t *testing.T) {
+	testCases := []struct {
+		input render.Theme
+	}{
+		{render.Themes["ocean"]},
+	}
+
+	for _, testCase := range testCases {
+		s := &render.Set{Theme: testCase.input}
+		output := s.GetTheme()
+		message := fmt.Sprintf("Theme incorrect get expected: %v, got: %v", testCase.input, output)
+
+		assert.Equal(t, testCase.input, output, message)
+	}
+}
+
+func TestGetColourMode(t *testing.T) {
+	testCases := []struct {
+		input render.ColourMode
+	}{
+		{render.ColourTrue},
+	}
+
+	for _, testCase := range testCases {
+		s := &render.Set{ColourMode: testCase.input}
+		output := s.GetColourMode()
+		message := fmt.Sprintf("ColourMode incorrect get expected: %v, got: %v", testCase.input, output)
+
+		assert.Equal(t, testCase.input, output, message)
+	}
+}
+
+func TestSetGradient(t *testing.T) {
+	testCases := []struct {
+		input render.Gradient
+	}{
+		{render.TrafficLightGradient},
+		{nil},
+	}
+
+	for _, testCase := range testCases {
+		s := &render.Set{}
+		s.SetGradient(testCase.input)
+		message := fmt.Sprintf("Gradient incorrectly set expected: %v; got %v", testCase.input, s.Gradient)
+
+		assert.Equal(t, testCase.input, s.Gradient, message)
+	}
+}
+
+func TestSetThresholds(t *testing.T) {
+	testCases := []struct {
+		input []render.Threshold
+	}{
+		{[]render.Threshold{{Percentage: 50.0, Colour: render.Green}}},
+		{nil},
+	}
+
+	for _, testCase := range testCases {
+		s := &render.Set{}
+		s.SetThresholds(testCase.input)
+		message := fmt.Sprintf("Thresholds incorrectly set expected: %v; got %v", testCase.input, s.Thresholds)
+
+		assert.Equal(t, testCase.input, s.Thresholds, message)
+	}
+}
+
+func TestSetStatus(t *testing.T) {
+	testCases := []struct {
+		input render.Status
+	}{
+		{render.StatusWarning},
+		{render.StatusError},
+	}
+
+	for _, testCase := range testCases {
+		s := &render.Set{}
+		s.SetStatus(testCase.input)
+		message := fmt.Sprintf("Status incorrectly set expected: %v; got %v", testCase.input, s.Status)
+
+		assert.Equal(t, testCase.input, s.Status, message)
+	}
+}
+
+func TestSetPercentage(t *testing.T) {
+	testCases := []struct {
+		input float64
+	}{
+		{0.0},
+		{42.5},
+	}
+
+	for _, testCase := range testCases {
+		s := &render.Set{}
+		s.SetPercentage(testCase.input)
+		message := fmt.Sprintf("Percentage incorrectly set expected: %v; got %v", testCase.input, s.Percentage)
+
+		assert.Equal(t, testCase.input, s.Percentage, message)
+	}
+}
+
+func TestGetGradient(t *testing.T) {
+	testCases := []struct {
+		input render.Gradient
+	}{
+		{render.TrafficLightGradient},
+	}
+
+	for _, testCase := range testCases {
+		s := &render.Set{Gradient: testCase.input}
+		output := s.GetGradient()
+		message := fmt.Sprintf("Gradient incorrect get expected: %v, got: %v", testCase.input, output)
+
+		assert.Equal(t, testCase.input, output, message)
+	}
+}
+
+func TestGetThresholds(t *testing.T) {
+	testCases := []struct {
+		input []render.Threshold
+	}{
+		{[]render.Threshold{{Percentage: 10.0, Colour: render.Red}}},
+	}
+
+	for _, testCase := range testCases {
+		s := &render.Set{Thresholds: testCase.input}
+		output := s.GetThresholds()
+		message := fmt.Sprintf("Thresholds incorrect get expected: %v, got: %v", testCase.input, output)
+
+		assert.Equal(t, testCase.input, output, message)
+	}
+}
+
+func TestGetStatus(t *testing.T) {
+	testCases := []struct {
+		input render.Status
+	}{
+		{render.StatusError},
+	}
+
+	for _, testCase := range testCases {
+		s := &render.Set{Status: testCase.input}
+		output := s.GetStatus()
+		message := fmt.Sprintf("Status incorrect get expected: %v, got: %v", testCase.input, output)
+
+		assert.Equal(t, testCase.input, output, message)
+	}
+}
+
+func TestGetPercentage(t *testing.T) {
+	testCases := []struct {
+		input float64
+	}{
+		{75.0},
+	}
+
+	for _, testCase := range testCases {
+		s := &render.Set{Percentage: testCase.input}
+		output := s.GetPercentage()
+		message := fmt.Sprintf("Percentage incorrect get expected: %v, got: %v", testCase.input, output)
+
+		assert.Equal(t, testCase.input, output, message)
+	}
+}
+
+func TestSetPreset(t *testing.T) {
+	testCases := []struct {
+		input render.Preset
+	}{
+		{render.ClassicPreset},
+		{render.BraillePreset},
+	}
+
+	for _, testCase := range testCases {
+		s := &render.Set{}
+		s.SetPreset(testCase.input)
+		output := render.Preset{s.FinishedIterationSymbol, s.CurrentIterationSymbol, s.RemainingIterationSymbol, s.LParen, s.RParen}
+		message := fmt.Sprintf("Preset incorrectly set expected: %v; got %v", testCase.input, output)
+
+		assert.Equal(t, testCase.input, output, message)
+	}
+}
+
+func TestSetUnicode(t *testing.T) {
+	testCases := []struct {
+		input          render.Preset
+		enabled        bool
+		expectedOutput render.Preset
+	}{
+		{render.DefaultPreset, false, render.ASCIIPreset},
+		{render.DefaultPreset, true, render.DefaultPreset},
+		{render.ASCIIPreset, true, render.DefaultPreset},
+		{render.ASCIIPreset, false, render.ASCIIPreset},
+		{render.ClassicPreset, false, render.ClassicPreset},
+		{render.Preset{"=", render.DefaultCurrentIterationSymbol, "_", "(", ")"}, false, render.Preset{"=", "#", "_", "(", ")"}},
+	}
+
+	for _, testCase := range testCases {
+		s := &render.Set{}
+		s.SetPreset(testCase.input)
+		s.SetUnicode(testCase.enabled)
+		output := s.GetPreset()
+		message := fmt.Sprintf("Symbols incorrect expected: %v; got %v", testCase.expectedOutput, output)
+
+		assert.Equal(t, testCase.expectedOutput, output, message)
+	}
+}
+
+func TestGetPreset(t *testing.T) {
+	testCases := []struct {
+		input render.Preset
+	}{
+		{render.DotsPreset},
+	}
+
+	for _, testCase := range testCases {
+		s := &render.Set{
+			FinishedIterationSymbol:  testCase.input.FinishedIterationSymbol,
+			CurrentIterationSymbol:   testCase.input.CurrentIterationSymbol,
+			RemainingIterationSymbol: testCase.input.RemainingIterationSymbol,
+			LParen:                   testCase.input.LParen,
+			RParen:                   testCase.input.RParen,
+		}
+
+		output := s.GetPreset()
+		message := fmt.Sprintf("Preset incorrect get expected: %v, got: %v", testCase.input, output)
+
+		assert.Equal(t, testCase.input, output, message)
+	}
+}
+
+func TestPaint(t *testing.T) {
+	theme := render.Theme{Statistics: render.Style{Foreground: render.Cyan}}
+	testCases := []struct {
+		mode           render.ColourMode
+		segment        render.Segment
+		input          string
+		expectedOutput string
+	}{
+		{render.Colour16, render.StatisticsSegment, "1.0/5.0 20.0%", "\033[36m1.0/5.0 20.0%\033[0m"},
+		{render.Colour16, render.ETASegment, "[elapsed: 00m:00s]", "[elapsed: 00m:00s]"},
+		{render.ColourNone, render.StatisticsSegment, "1.0/5.0 20.0%", "1.0/5.0 20.0%"},
+	}
+
+	for _, testCase := range testCases {
+		s := &render.Set{Theme: theme, ColourMode: testCase.mode}
+		output := s.Paint(testCase.segment, testCase.input)
+		message := fmt.Sprintf("Painted output incorrect expected: %q; got: %q", testCase.expectedOutput, output)
+
+		assert.Equal(t, testCase.expectedOutput, output, message)
+	}
+}
+
 func TestSetIdealLineSize(t *testing.T) {
 	testCases := []struct {
 		description      string
@@ -456,6 +776,7 @@ func TestSetIdealLineSize(t *testing.T) {
 	}{
 		{"", "|", "|", 80, nil, 80 - render.NumberOfCharacters - render.NumberOfCharactersBuffer},
 		{"", "|", "|", 80, errors.New("An error"), 80 - render.NumberOfCharacters - render.NumberOfCharactersBuffer},
+		{"", "|", "|", 20, nil, 0},
 	}
 
 	for _, testCase := range testCases {
@@ -515,3 +836,44 @@ func TestCreateBarString(t *testing.T) {
 		assert.Equal(t, testCase.expectedOutput, output, message)
 	}
 }
+
+func TestCreateBarStringWithTheme(t *testing.T) {
+	theme := render.Theme{
+		Description: render.Style{Bold: true},
+		Finished:    render.Style{Foreground: render.Green},
+		Current:     render.Style{Foreground: render.Yellow},
+		Remaining:   render.Style{Foreground: render.BrightBlack},
+		Parens:      render.Style{Foreground: render.Blue},
+	}
+
+	testCases := []struct {
+		numStepsCompleted int
+		mode              render.ColourMode
+		description       string
+		expectedOutput    string
+	}{
+		{2, render.ColourNone, "", "|=>--|"},
+		{0, render.Colour16, "", "\033[34m|\033[0m\033[90m----\033[0m\033[34m|\033[0m"},
+		{2, render.Colour16, "", "\033[34m|\033[0m\033[32m=\033[0m\033[33m>\033[0m\033[90m--\033[0m\033[34m|\033[0m"},
+		{4, render.Colour16, "Hello:", "\033[1mHello:\033[0m \033[34m|\033[0m\033[32m===\033[0m\033[33m>\033[0m\033[34m|\033[0m"},
+	}
+
+	for _, testCase := range testCases {
+		s := &render.Set{
+			FinishedIterationSymbol:  "=",
+			CurrentIterationSymbol:   ">",
+			RemainingIterationSymbol: "-",
+			LineSize:                 4,
+			Description:              testCase.description,
+			LParen:                   "|",
+			RParen:                   "|",
+			Theme:                    theme,
+			ColourMode:               testCase.mode,
+		}
+
+		output := s.CreateBarString(testCase.numStepsCompleted)
+		message := fmt.Sprintf("Output incorrect expected: %q; got: %q", testCase.expectedOutput, output)
+
+		assert.Equal(t, testCase.expectedOutput, output, message)
+	}
+}
@@ -0,0 +1,96 @@
+/*
+ * The MIT License
+ *
+ * Copyright 2018 kinsey40.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * File:   taskbar.go
+ * Author: kinsey40
+ *
+ * Created on 19 October 2026, 18:10
+ *
+ * Taskbar creates the OSC 9;4 escape sequences which display the progress
+ * of the progress bar in the tab or taskbar of terminals which support them
+ * (e.g. Windows Terminal, ConEmu, WezTerm and Ghostty).
+ *
+ */
+
+package render
+
+import (
+	"fmt"
+	"math"
+)
+
+// TaskbarState is the state displayed by the tab or taskbar progress
+type TaskbarState int
+
+// The supported taskbar states, TaskbarHidden removes the progress
+// and TaskbarIndeterminate displays progress without a percentage.
+const (
+	TaskbarHidden TaskbarState = iota
+	TaskbarNormal
+	TaskbarError
+	TaskbarIndeterminate
+	TaskbarPaused
+)
+
+// TaskbarSequence creates the escape sequence setting the tab or taskbar
+// progress to the state, with the percentage rounded down to a whole number.
+func TaskbarSequence(state TaskbarState, percentage float64) string {
+	percent := int(math.Max(0.0, math.Min(100.0, percentage)))
+	if state == TaskbarHidden || state == TaskbarIndeterminate {
+		percent = 0
+	}
+
+	return fmt.Sprintf("\033]9;4;%d;%d\007", int(state), percent)
+}
+
+// SetTaskbar sets the Taskbar value, if true the progress is also displayed
+// in the tab or taskbar of the terminal. StatusWarning is displayed as the
+// paused state and StatusError as the error state. The progress is removed
+// once the progress bar reaches 100%, unless the Status is StatusError.
+func (s *Set) SetTaskbar(taskbar bool) {
+	s.Taskbar = taskbar
+}
+
+// GetTaskbar gets the Taskbar value
+func (s *Set) GetTaskbar() bool {
+	return s.Taskbar
+}
+
+// taskbarSequence creates the escape sequence for the Percentage and Status,
+// this is empty unless Taskbar is true.
+func (s *Set) taskbarSequence() string {
+	if !s.Taskbar {
+		return ""
+	}
+
+	switch {
+	case s.Status == StatusError:
+		return TaskbarSequence(TaskbarError, s.Percentage)
+	case s.Percentage >= 100.0:
+		return TaskbarSequence(TaskbarHidden, 0.0)
+	case s.Status == StatusWarning:
+		return TaskbarSequence(TaskbarPaused, s.Percentage)
+	}
+
+	return TaskbarSequence(TaskbarNormal, s.Percentage)
+}
@@ -0,0 +1,92 @@
+/*
+ * The MIT License
+ *
+ * Copyright 2018 kinsey40.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * File:   taskbar_test.go
+ * Author: kinsey40
+ *
+ * Created on 19 October 2026, 18:10
+ *
+ * The test file for taskbar.go
+ *
+ */
+
+package render_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/kinsey40/pbar/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTaskbarSequence(t *testing.T) {
+	testCases := []struct {
+		state            render.TaskbarState
+		percentage       float64
+		expectedSequence string
+	}{
+		{render.TaskbarHidden, 50.0, "\033]9;4;0;0\007"},
+		{render.TaskbarNormal, 42.7, "\033]9;4;1;42\007"},
+		{render.TaskbarNormal, 120.0, "\033]9;4;1;100\007"},
+		{render.TaskbarError, 30.0, "\033]9;4;2;30\007"},
+		{render.TaskbarIndeterminate, 30.0, "\033]9;4;3;0\007"},
+		{render.TaskbarPaused, -1.0, "\033]9;4;4;0\007"},
+	}
+
+	for _, testCase := range testCases {
+		output := render.TaskbarSequence(testCase.state, testCase.percentage)
+		message := fmt.Sprintf("Sequence incorrect expected: %q; got: %q", testCase.expectedSequence, output)
+
+		assert.Equal(t, testCase.expectedSequence, output, message)
+	}
+}
+
+func TestSetTaskbar(t *testing.T) {
+	testCases := []struct {
+		taskbar          bool
+		status           render.Status
+		percentage       float64
+		expectedSequence string
+	}{
+		{false, render.StatusNormal, 50.0, ""},
+		{true, render.StatusNormal, 50.0, "\033]9;4;1;50\007"},
+		{true, render.StatusWarning, 50.0, "\033]9;4;4;50\007"},
+		{true, render.StatusError, 50.0, "\033]9;4;2;50\007"},
+		{true, render.StatusNormal, 100.0, "\033]9;4;0;0\007"},
+		{true, render.StatusError, 100.0, "\033]9;4;2;100\007"},
+	}
+
+	for _, testCase := range testCases {
+		s := &render.Set{LineSize: 2, FinishedIterationSymbol: "#", CurrentIterationSymbol: "#", RemainingIterationSymbol: "-"}
+		s.SetTaskbar(testCase.taskbar)
+		s.SetStatus(testCase.status)
+		s.SetPercentage(testCase.percentage)
+		output := s.CreateBarString(1)
+
+		assert.Equal(t, testCase.taskbar, s.GetTaskbar(), fmt.Sprintf("Taskbar incorrect expected: %v; got: %v", testCase.taskbar, s.GetTaskbar()))
+		assert.True(t, strings.HasSuffix(output, "#-"), fmt.Sprintf("Bar missing from: %q", output))
+		assert.Equal(t, testCase.expectedSequence, strings.TrimSuffix(output, "#-"), fmt.Sprintf("Sequence incorrect expected: %q; got: %q", testCase.expectedSequence, output))
+	}
+}
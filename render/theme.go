@@ -0,0 +1,402 @@
+/*
+ * The MIT License
+ *
+ * Copyright 2018 kinsey40.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * File:   theme.go
+ * Author: kinsey40
+ *
+ * Created on 19 October 2026, 09:12
+ *
+ * Theme enables the segments of the progress bar to be coloured and styled.
+ * Colours are degraded to match the colour depth of the terminal, and are
+ * disabled entirely when NO_COLOR is set or the writer is not a terminal.
+ *
+ */
+
+package render
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+// ColourMode is the colour depth that the writer is able to display.
+// ColourAuto defers the decision until the progress bar is initialized,
+// at which point the mode is detected from the writer and environment.
+type ColourMode int
+
+// The supported colour modes
+const (
+	ColourNone ColourMode = iota
+	Colour16
+	Colour256
+	ColourTrue
+	ColourAuto
+)
+
+// ColourType describes how the value of a Colour should be interpreted
+type ColourType int
+
+// The supported colour types, DefaultColour leaves the terminal colour as is
+const (
+	DefaultColour ColourType = iota
+	BasicColour
+	IndexedColour
+	RGBColour
+)
+
+// Segment identifies a section of the progress bar that can be styled
+type Segment int
+
+// The segments of the progress bar
+const (
+	DescriptionSegment Segment = iota
+	FinishedSegment
+	CurrentSegment
+	RemainingSegment
+	ParensSegment
+	StatisticsSegment
+	ETASegment
+)
+
+// Environment and terminal functions used to detect colour support
+var (
+	LookupEnv  = os.LookupEnv
+	IsTerminal = terminal.IsTerminal
+)
+
+// DefaultColourMode is the colour mode used by new settings
+var DefaultColourMode = ColourAuto
+
+// Colour holds a single terminal colour. Basic colours use the 16 colour
+// palette (Index 0-15), indexed colours use the 256 colour palette and
+// RGB colours are 24-bit.
+type Colour struct {
+	Type  ColourType
+	Index uint8
+	R     uint8
+	G     uint8
+	B     uint8
+}
+
+// The basic terminal colours
+var (
+	Black         = Basic(0)
+	Red           = Basic(1)
+	Green         = Basic(2)
+	Yellow        = Basic(3)
+	Blue          = Basic(4)
+	Magenta       = Basic(5)
+	Cyan          = Basic(6)
+	White         = Basic(7)
+	BrightBlack   = Basic(8)
+	BrightRed     = Basic(9)
+	BrightGreen   = Basic(10)
+	BrightYellow  = Basic(11)
+	BrightBlue    = Basic(12)
+	BrightMagenta = Basic(13)
+	BrightCyan    = Basic(14)
+	BrightWhite   = Basic(15)
+)
+
+// basicPalette holds the (approximate) RGB values of the 16 basic colours,
+// used when a colour must be degraded to the 16 colour palette.
+var basicPalette = [16][3]uint8{
+	{0, 0, 0}, {205, 0, 0}, {0, 205, 0}, {205, 205, 0},
+	{0, 0, 238}, {205, 0, 205}, {0, 205, 205}, {229, 229, 229},
+	{127, 127, 127}, {255, 0, 0}, {0, 255, 0}, {255, 255, 0},
+	{92, 92, 255}, {255, 0, 255}, {0, 255, 255}, {255, 255, 255},
+}
+
+// Basic creates a colour from the 16 colour palette
+func Basic(index uint8) Colour {
+	return Colour{Type: BasicColour, Index: index % 16}
+}
+
+// Indexed creates a colour from the 256 colour palette
+func Indexed(index uint8) Colour {
+	return Colour{Type: IndexedColour, Index: index}
+}
+
+// RGB creates a 24-bit colour
+func RGB(r, g, b uint8) Colour {
+	return Colour{Type: RGBColour, R: r, G: g, B: b}
+}
+
+// IsDefault returns true if the colour leaves the terminal colour unchanged
+func (c Colour) IsDefault() bool {
+	return c.Type == DefaultColour
+}
+
+// sequence returns the SGR parameters selecting the colour in the given mode,
+// degrading the colour if the mode cannot display it.
+func (c Colour) sequence(mode ColourMode, background bool) string {
+	offset := 0
+	if background {
+		offset = 10
+	}
+
+	switch c.Type {
+	case BasicColour:
+		return basicSequence(c.Index, offset)
+	case IndexedColour:
+		if mode == Colour16 {
+			return basicSequence(nearestBasic(indexedToRGB(c.Index)), offset)
+		}
+
+		return fmt.Sprintf("%d;5;%d", 38+offset, c.Index)
+	case RGBColour:
+		switch mode {
+		case Colour16:
+			return basicSequence(nearestBasic([3]uint8{c.R, c.G, c.B}), offset)
+		case Colour256:
+			return fmt.Sprintf("%d;5;%d", 38+offset, rgbToIndexed(c.R, c.G, c.B))
+		}
+
+		return fmt.Sprintf("%d;2;%d;%d;%d", 38+offset, c.R, c.G, c.B)
+	}
+
+	return ""
+}
+
+// basicSequence returns the SGR parameter for a colour in the 16 colour palette
+func basicSequence(index uint8, offset int) string {
+	if index < 8 {
+		return strconv.Itoa(30 + offset + int(index))
+	}
+
+	return strconv.Itoa(90 + offset + int(index) - 8)
+}
+
+// rgbToIndexed finds the closest colour within the 6x6x6 colour cube of
+// the 256 colour palette.
+func rgbToIndexed(r, g, b uint8) uint8 {
+	scale := func(v uint8) int { return (int(v)*5 + 127) / 255 }
+
+	return uint8(16 + 36*scale(r) + 6*scale(g) + scale(b))
+}
+
+// indexedToRGB converts a colour from the 256 colour palette to RGB
+func indexedToRGB(index uint8) [3]uint8 {
+	switch {
+	case index < 16:
+		return basicPalette[index]
+	case index < 232:
+		i := int(index) - 16
+		level := func(v int) uint8 {
+			if v == 0 {
+				return 0
+			}
+			return uint8(55 + v*40)
+		}
+
+		return [3]uint8{level(i / 36), level((i / 6) % 6), level(i % 6)}
+	}
+
+	grey := uint8(8 + (int(index)-232)*10)
+
+	return [3]uint8{grey, grey, grey}
+}
+
+// nearestBasic finds the colour in the 16 colour palette closest to rgb
+func nearestBasic(rgb [3]uint8) uint8 {
+	best, bestDistance := 0, -1
+	for index, colour := range basicPalette {
+		distance := 0
+		for i := range colour {
+			d := int(rgb[i]) - int(colour[i])
+			distance += d * d
+		}
+
+		if bestDistance < 0 || distance < bestDistance {
+			best, bestDistance = index, distance
+		}
+	}
+
+	return uint8(best)
+}
+
+// Style holds the foreground and background colours for a segment,
+// alongside whether the segment is displayed in bold.
+type Style struct {
+	Foreground Colour
+	Background Colour
+	Bold       bool
+}
+
+// Apply wraps the string in the escape sequences required to display
+// the style. No escape sequences are added if the mode is ColourNone
+// (or has not yet been detected), or if the style is empty.
+func (st Style) Apply(s string, mode ColourMode) string {
+	if s == "" || mode == ColourNone || mode == ColourAuto {
+		return s
+	}
+
+	params := make([]string, 0, 3)
+	if st.Bold {
+		params = append(params, "1")
+	}
+
+	if !st.Foreground.IsDefault() {
+		params = append(params, st.Foreground.sequence(mode, false))
+	}
+
+	if !st.Background.IsDefault() {
+		params = append(params, st.Background.sequence(mode, true))
+	}
+
+	if len(params) == 0 {
+		return s
+	}
+
+	return fmt.Sprintf("\033[%sm%s\033[0m", strings.Join(params, ";"), s)
+}
+
+// Theme holds a style for each segment of the progress bar
+type Theme struct {
+	Description Style
+	Finished    Style
+	Current     Style
+	Remaining   Style
+	Parens      Style
+	Statistics  Style
+	ETA         Style
+	Warning     Style
+	Error       Style
+}
+
+// DefaultTheme applies no styling to the progress bar
+var DefaultTheme = Theme{}
+
+// Themes holds the built-in themes, these can be retrieved by name
+// using LookupTheme.
+var Themes = map[string]Theme{
+	"default": DefaultTheme,
+	"classic": {
+		Description: Style{Bold: true},
+		Finished:    Style{Foreground: Green},
+		Current:     Style{Foreground: BrightGreen},
+		Remaining:   Style{Foreground: BrightBlack},
+		Statistics:  Style{Foreground: Cyan},
+		ETA:         Style{Foreground: Yellow},
+	},
+	"ocean": {
+		Description: Style{Foreground: RGB(0, 175, 215), Bold: true},
+		Finished:    Style{Foreground: RGB(0, 95, 175)},
+		Current:     Style{Foreground: RGB(0, 175, 255)},
+		Remaining:   Style{Foreground: RGB(48, 48, 48)},
+		Parens:      Style{Foreground: RGB(0, 95, 135)},
+		Statistics:  Style{Foreground: RGB(95, 215, 255)},
+		ETA:         Style{Foreground: RGB(135, 175, 215)},
+	},
+	"forest": {
+		Description: Style{Foreground: Indexed(106), Bold: true},
+		Finished:    Style{Foreground: Indexed(28)},
+		Current:     Style{Foreground: Indexed(34)},
+		Remaining:   Style{Foreground: Indexed(236)},
+		Parens:      Style{Foreground: Indexed(94)},
+		Statistics:  Style{Foreground: Indexed(149)},
+		ETA:         Style{Foreground: Indexed(137)},
+	},
+	"sunset": {
+		Description: Style{Foreground: RGB(255, 135, 0), Bold: true},
+		Finished:    Style{Foreground: RGB(215, 95, 95)},
+		Current:     Style{Foreground: RGB(255, 175, 0)},
+		Remaining:   Style{Foreground: RGB(88, 58, 88)},
+		Parens:      Style{Foreground: RGB(175, 95, 135)},
+		Statistics:  Style{Foreground: RGB(255, 175, 135)},
+		ETA:         Style{Foreground: RGB(215, 135, 175)},
+	},
+	"inverse": {
+		Description: Style{Bold: true},
+		Finished:    Style{Foreground: Black, Background: White},
+		Current:     Style{Foreground: Black, Background: BrightWhite},
+		Statistics:  Style{Bold: true},
+	},
+}
+
+// LookupTheme returns the built-in theme with the given name
+func LookupTheme(name string) (Theme, error) {
+	theme, ok := Themes[name]
+	if !ok {
+		return Theme{}, fmt.Errorf("Theme: %q does not exist!", name)
+	}
+
+	return theme, nil
+}
+
+// Style returns the style of the given segment
+func (t Theme) Style(segment Segment) Style {
+	switch segment {
+	case DescriptionSegment:
+		return t.Description
+	case FinishedSegment:
+		return t.Finished
+	case CurrentSegment:
+		return t.Current
+	case RemainingSegment:
+		return t.Remaining
+	case ParensSegment:
+		return t.Parens
+	case StatisticsSegment:
+		return t.Statistics
+	case ETASegment:
+		return t.ETA
+	}
+
+	return Style{}
+}
+
+// DetectColourMode examines the writer and environment to determine the
+// colour depth available. Colour is disabled when NO_COLOR is set, when
+// TERM is "dumb" or when the writer is not a terminal.
+func DetectColourMode(w io.Writer) ColourMode {
+	if value, ok := LookupEnv("NO_COLOR"); ok && value != "" {
+		return ColourNone
+	}
+
+	f, ok := w.(interface{ Fd() uintptr })
+	if !ok || !IsTerminal(int(f.Fd())) {
+		return ColourNone
+	}
+
+	term, _ := LookupEnv("TERM")
+	if term == "dumb" {
+		return ColourNone
+	}
+
+	colourTerm, _ := LookupEnv("COLORTERM")
+	if colourTerm == "truecolor" || colourTerm == "24bit" {
+		return ColourTrue
+	}
+
+	if strings.Contains(term, "256color") {
+		return Colour256
+	}
+
+	return Colour16
+}
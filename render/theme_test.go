@@ -0,0 +1,169 @@
+/*
+ * The MIT License
+ *
+ * Copyright 2018 kinsey40.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * File:   theme_test.go
+ * Author: kinsey40
+ *
+ * Created on 19 October 2026, 09:12
+ *
+ * The test file for theme.go
+ *
+ */
+
+package render_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/kinsey40/pbar/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStyleApply(t *testing.T) {
+	testCases := []struct {
+		style          render.Style
+		mode           render.ColourMode
+		input          string
+		expectedOutput string
+	}{
+		{render.Style{Foreground: render.Red}, render.ColourNone, "Hello", "Hello"},
+		{render.Style{Foreground: render.Red}, render.ColourAuto, "Hello", "Hello"},
+		{render.Style{Foreground: render.Red}, render.Colour16, "", ""},
+		{render.Style{}, render.ColourTrue, "Hello", "Hello"},
+		{render.Style{Foreground: render.Red}, render.Colour16, "Hello", "\033[31mHello\033[0m"},
+		{render.Style{Foreground: render.BrightRed}, render.Colour16, "Hello", "\033[91mHello\033[0m"},
+		{render.Style{Background: render.Blue}, render.Colour16, "Hello", "\033[44mHello\033[0m"},
+		{render.Style{Foreground: render.Red, Bold: true}, render.Colour16, "Hello", "\033[1;31mHello\033[0m"},
+		{render.Style{Foreground: render.Indexed(208)}, render.Colour256, "Hello", "\033[38;5;208mHello\033[0m"},
+		{render.Style{Foreground: render.Indexed(196)}, render.Colour16, "Hello", "\033[91mHello\033[0m"},
+		{render.Style{Foreground: render.RGB(1, 2, 3)}, render.ColourTrue, "Hello", "\033[38;2;1;2;3mHello\033[0m"},
+		{render.Style{Background: render.RGB(1, 2, 3)}, render.ColourTrue, "Hello", "\033[48;2;1;2;3mHello\033[0m"},
+		{render.Style{Foreground: render.RGB(255, 0, 0)}, render.Colour256, "Hello", "\033[38;5;196mHello\033[0m"},
+		{render.Style{Foreground: render.RGB(0, 0, 0)}, render.Colour16, "Hello", "\033[30mHello\033[0m"},
+	}
+
+	for _, testCase := range testCases {
+		output := testCase.style.Apply(testCase.input, testCase.mode)
+		message := fmt.Sprintf("Styled output incorrect expected: %q; got: %q", testCase.expectedOutput, output)
+
+		assert.Equal(t, testCase.expectedOutput, output, message)
+	}
+}
+
+func TestThemeStyle(t *testing.T) {
+	theme := render.Theme{
+		Description: render.Style{Foreground: render.Red},
+		Finished:    render.Style{Foreground: render.Green},
+		Current:     render.Style{Foreground: render.Yellow},
+		Remaining:   render.Style{Foreground: render.Blue},
+		Parens:      render.Style{Foreground: render.Magenta},
+		Statistics:  render.Style{Foreground: render.Cyan},
+		ETA:         render.Style{Foreground: render.White},
+	}
+
+	testCases := []struct {
+		segment       render.Segment
+		expectedStyle render.Style
+	}{
+		{render.DescriptionSegment, theme.Description},
+		{render.FinishedSegment, theme.Finished},
+		{render.CurrentSegment, theme.Current},
+		{render.RemainingSegment, theme.Remaining},
+		{render.ParensSegment, theme.Parens},
+		{render.StatisticsSegment, theme.Statistics},
+		{render.ETASegment, theme.ETA},
+		{render.Segment(-1), render.Style{}},
+	}
+
+	for _, testCase := range testCases {
+		style := theme.Style(testCase.segment)
+		message := fmt.Sprintf("Style incorrect expected: %v; got: %v", testCase.expectedStyle, style)
+
+		assert.Equal(t, testCase.expectedStyle, style, message)
+	}
+}
+
+func TestLookupTheme(t *testing.T) {
+	testCases := []struct {
+		name        string
+		expectError bool
+	}{
+		{"default", false},
+		{"classic", false},
+		{"ocean", false},
+		{"forest", false},
+		{"sunset", false},
+		{"inverse", false},
+		{"Hello", true},
+	}
+
+	for _, testCase := range testCases {
+		theme, err := render.LookupTheme(testCase.name)
+		if testCase.expectError {
+			assert.Error(t, err, fmt.Sprintf("Expected error not raised"))
+		} else {
+			assert.NoError(t, err, fmt.Sprintf("Unexpected error raised: %v", err))
+			assert.Equal(t, render.Themes[testCase.name], theme, fmt.Sprintf("Theme incorrect for: %v", testCase.name))
+		}
+	}
+}
+
+func TestDetectColourMode(t *testing.T) {
+	testCases := []struct {
+		env          map[string]string
+		writer       io.Writer
+		isTerminal   bool
+		expectedMode render.ColourMode
+	}{
+		{map[string]string{"TERM": "xterm"}, os.Stdout, true, render.Colour16},
+		{map[string]string{"TERM": "xterm-256color"}, os.Stdout, true, render.Colour256},
+		{map[string]string{"TERM": "xterm-256color", "COLORTERM": "truecolor"}, os.Stdout, true, render.ColourTrue},
+		{map[string]string{"TERM": "xterm", "COLORTERM": "24bit"}, os.Stdout, true, render.ColourTrue},
+		{map[string]string{"TERM": "xterm", "NO_COLOR": "1"}, os.Stdout, true, render.ColourNone},
+		{map[string]string{"TERM": "xterm", "NO_COLOR": ""}, os.Stdout, true, render.Colour16},
+		{map[string]string{"TERM": "dumb"}, os.Stdout, true, render.ColourNone},
+		{map[string]string{"TERM": "xterm"}, os.Stdout, false, render.ColourNone},
+		{map[string]string{"TERM": "xterm"}, new(bytes.Buffer), true, render.ColourNone},
+	}
+
+	for _, testCase := range testCases {
+		env := testCase.env
+		isTerminal := testCase.isTerminal
+		render.LookupEnv = func(key string) (string, bool) {
+			value, ok := env[key]
+			return value, ok
+		}
+		render.IsTerminal = func(_ int) bool { return isTerminal }
+
+		mode := render.DetectColourMode(testCase.writer)
+		message := fmt.Sprintf("Colour mode incorrect expected: %v; got: %v for env: %v", testCase.expectedMode, mode, testCase.env)
+
+		assert.Equal(t, testCase.expectedMode, mode, message)
+	}
+
+	render.LookupEnv = os.LookupEnv
+}
@@ -0,0 +1,84 @@
+/*
+ * The MIT License
+ *
+ * Copyright 2018 kinsey40.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * File:   unit.go
+ * Author: kinsey40
+ *
+ * Created on 19 October 2026, 19:00
+ *
+ * Unit determines how the values, and the rate, of the progress bar are
+ * displayed, e.g. as a number of iterations or as a number of bytes.
+ *
+ */
+
+package render
+
+import "fmt"
+
+// Unit is the unit of the values of the progress bar
+type Unit int
+
+// The supported units, UnitNone displays the values as numbers and the
+// rate in iterations per second. UnitBytes displays the values and
+// the rate in binary multiples of bytes (KiB, MiB, GiB etc.).
+const (
+	UnitNone Unit = iota
+	UnitBytes
+)
+
+// byteSuffixes are the suffixes of the binary multiples of bytes
+var byteSuffixes = []string{"B", "KiB", "MiB", "GiB", "TiB", "PiB", "EiB"}
+
+// FormatValue formats the value in the unit
+func (u Unit) FormatValue(value float64) string {
+	if u != UnitBytes {
+		return fmt.Sprintf("%.1f", value)
+	}
+
+	index := 0
+	for value >= 1024.0 && index < len(byteSuffixes)-1 {
+		value /= 1024.0
+		index++
+	}
+
+	if index == 0 {
+		return fmt.Sprintf("%.0f %s", value, byteSuffixes[index])
+	}
+
+	return fmt.Sprintf("%.1f %s", value, byteSuffixes[index])
+}
+
+// FormatRate formats the rate (per second) in the unit, a
+// negative rate means the rate is not known.
+func (u Unit) FormatRate(rate float64) string {
+	switch {
+	case u == UnitBytes && rate < 0.0:
+		return "N/A B/s"
+	case u == UnitBytes:
+		return u.FormatValue(rate) + "/s"
+	case rate < 0.0:
+		return "N/A iters/sec"
+	}
+
+	return fmt.Sprintf("%.2f iters/sec", rate)
+}
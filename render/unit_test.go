@@ -0,0 +1,97 @@
+/*
+ * The MIT License
+ *
+ * Copyright 2018 kinsey40.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * File:   unit_test.go
+ * Author: kinsey40
+ *
+ * Created on 19 October 2026, 19:00
+ *
+ * The test file for unit.go
+ *
+ */
+
+package render_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/kinsey40/pbar/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatValue(t *testing.T) {
+	testCases := []struct {
+		unit          render.Unit
+		value         float64
+		expectedValue string
+	}{
+		{render.UnitNone, 1536.0, "1536.0"},
+		{render.UnitBytes, 512.0, "512 B"},
+		{render.UnitBytes, 1536.0, "1.5 KiB"},
+		{render.UnitBytes, 1024.0 * 1024.0 * 1024.0, "1.0 GiB"},
+		{render.UnitBytes, 3.0 * 1024.0 * 1024.0 * 1024.0 * 1024.0 * 1024.0 * 1024.0 * 1024.0, "3072.0 EiB"},
+	}
+
+	for _, testCase := range testCases {
+		output := testCase.unit.FormatValue(testCase.value)
+		message := fmt.Sprintf("Value incorrect expected: %v; got: %v", testCase.expectedValue, output)
+
+		assert.Equal(t, testCase.expectedValue, output, message)
+	}
+}
+
+func TestFormatRate(t *testing.T) {
+	testCases := []struct {
+		unit         render.Unit
+		rate         float64
+		expectedRate string
+	}{
+		{render.UnitNone, 2.5, "2.50 iters/sec"},
+		{render.UnitNone, -1.0, "N/A iters/sec"},
+		{render.UnitBytes, 2048.0, "2.0 KiB/s"},
+		{render.UnitBytes, -1.0, "N/A B/s"},
+	}
+
+	for _, testCase := range testCases {
+		output := testCase.unit.FormatRate(testCase.rate)
+		message := fmt.Sprintf("Rate incorrect expected: %v; got: %v", testCase.expectedRate, output)
+
+		assert.Equal(t, testCase.expectedRate, output, message)
+	}
+}
+
+func TestUnitBytes(t *testing.T) {
+	v := &render.Vals{Stop: 4096.0, Current: 1024.0}
+	v.SetUnit(render.UnitBytes)
+	statistics, _ := v.Statistics(10)
+	assert.Equal(t, render.UnitBytes, v.GetUnit(), fmt.Sprintf("Unit incorrect: %v", v.GetUnit()))
+	assert.Equal(t, "1.0 KiB/4.0 KiB 25.0%", statistics, fmt.Sprintf("Statistics incorrect: %v", statistics))
+
+	c := &render.ClockVal{StartTime: time.Unix(0, 0), CurrentTime: time.Unix(2, 0)}
+	c.SetUnit(render.UnitBytes)
+	expected := "[elapsed: 00m:02s, left: 00m:06s, 512 B/s]"
+	speedMeter := c.CreateSpeedMeter(0.0, 4096.0, 1024.0)
+	assert.Equal(t, expected, speedMeter, fmt.Sprintf("Speed meter incorrect expected: %v; got: %v", expected, speedMeter))
+}
@@ -43,13 +43,16 @@ type Values interface {
 	SetStep(float64)
 	SetCurrent(float64)
 	SetIsObject(bool)
+	SetUnit(Unit)
 
 	GetStart() float64
 	GetStop() float64
 	GetStep() float64
 	GetCurrent() float64
 	GetIsObject() bool
+	GetUnit() Unit
 
+	Percentage() float64
 	Statistics(int) (string, int)
 }
 
@@ -60,6 +63,7 @@ type Vals struct {
 	Step     float64
 	Current  float64
 	IsObject bool
+	Unit     Unit
 }
 
 // NewValues generates a NewValues interface
@@ -94,6 +98,11 @@ func (v *Vals) SetIsObject(value bool) {
 	v.IsObject = value
 }
 
+// SetUnit sets the Unit value
+func (v *Vals) SetUnit(u Unit) {
+	v.Unit = u
+}
+
 // GetStart gets the Start value
 func (v *Vals) GetStart() float64 {
 	return v.Start
@@ -119,13 +128,24 @@ func (v *Vals) GetIsObject() bool {
 	return v.IsObject
 }
 
+// GetUnit gets the Unit value
+func (v *Vals) GetUnit() Unit {
+	return v.Unit
+}
+
+// Percentage returns the percentage of the progress bar that has
+// been completed.
+func (v *Vals) Percentage() float64 {
+	return v.Current / v.Stop * 100.0
+}
+
 // Statistics calculates all the numerical values relating to the
 // progression of the progress bar. These are then formed and returned
 // in a string, alongside the number of steps that have been completed.
 func (v *Vals) Statistics(linesize int) (string, int) {
 	ratio := v.Current / v.Stop
-	percentage := ratio * 100.0
-	statistics := fmt.Sprintf("%.1f/%.1f %.1f%%", v.Current, v.Stop, percentage)
+	percentage := v.Percentage()
+	statistics := fmt.Sprintf("%s/%s %.1f%%", v.Unit.FormatValue(v.Current), v.Unit.FormatValue(v.Stop), percentage)
 	numStepsCompleted := int(ratio * float64(linesize))
 
 	return statistics, numStepsCompleted
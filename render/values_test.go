@@ -224,6 +224,30 @@ func TestGetIsObject(t *testing.T) {
 	}
 }
 
+func TestPercentage(t *testing.T) {
+	testCases := []struct {
+		current            float64
+		stop               float64
+		expectedPercentage float64
+	}{
+		{1.0, 5.0, 20.0},
+		{5.0, 5.0, 100.0},
+		{0.0, 5.0, 0.0},
+	}
+
+	for _, testCase := range testCases {
+		v := &render.Vals{
+			Stop:    testCase.stop,
+			Current: testCase.current,
+		}
+
+		output := v.Percentage()
+		message := fmt.Sprintf("Percentage incorrect expected: %v; got: %v", testCase.expectedPercentage, output)
+
+		assert.Equal(t, testCase.expectedPercentage, output, message)
+	}
+}
+
 func TestStatistics(t *testing.T) {
 	testCases := []struct {
 		linesize                  int
@@ -0,0 +1,121 @@
+//go:build go1.21
+// +build go1.21
+
+/*
+ * The MIT License
+ *
+ * Copyright 2018 kinsey40.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * File:   slog.go
+ * Author: kinsey40
+ *
+ * Created on 19 October 2026, 10:50
+ *
+ * LogHandler is a log/slog handler which writes the log records above the
+ * progress bars. The current progress of the progress bar can optionally be
+ * attached to each record, so that logs written elsewhere carry the progress.
+ *
+ */
+
+package pbar
+
+import (
+	"context"
+	"log/slog"
+)
+
+// LogHandler wraps a slog.Handler, optionally attaching the progress of a
+// progress bar to each record. The handlers created by NewTextLogHandler
+// and NewJSONLogHandler write the records above the progress bar.
+type LogHandler struct {
+	handler  slog.Handler
+	itr      *Iterator
+	progress bool
+}
+
+// NewLogHandler wraps the handler, to write the records above the progress
+// bar the handler should write to the Writer of the progress bar.
+func NewLogHandler(bar Iterate, handler slog.Handler) *LogHandler {
+	return &LogHandler{handler: handler, itr: bar.iterator()}
+}
+
+// NewTextLogHandler creates a LogHandler which writes the records above
+// the progress bar using a slog.TextHandler.
+func NewTextLogHandler(bar Iterate, opts *slog.HandlerOptions) *LogHandler {
+	return NewLogHandler(bar, slog.NewTextHandler(bar.Writer(), opts))
+}
+
+// NewJSONLogHandler creates a LogHandler which writes the records above
+// the progress bar using a slog.JSONHandler.
+func NewJSONLogHandler(bar Iterate, opts *slog.HandlerOptions) *LogHandler {
+	return NewLogHandler(bar, slog.NewJSONHandler(bar.Writer(), opts))
+}
+
+// SetProgress sets whether the progress of the progress bar is attached
+// to each record, as a group named "progress" holding the percent,
+// current, total and description.
+//
+// Default Value: false
+func (h *LogHandler) SetProgress(value bool) {
+	h.progress = value
+}
+
+// Enabled reports whether the wrapped handler handles records at the level
+func (h *LogHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.handler.Enabled(ctx, level)
+}
+
+// Handle attaches the progress to the record, if enabled, and passes
+// the record to the wrapped handler.
+func (h *LogHandler) Handle(ctx context.Context, record slog.Record) error {
+	if h.progress {
+		record = record.Clone()
+		record.AddAttrs(h.progressAttr())
+	}
+
+	return h.handler.Handle(ctx, record)
+}
+
+// WithAttrs returns a LogHandler whose wrapped handler has the attributes
+func (h *LogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &LogHandler{handler: h.handler.WithAttrs(attrs), itr: h.itr, progress: h.progress}
+}
+
+// WithGroup returns a LogHandler whose wrapped handler has the group
+func (h *LogHandler) WithGroup(name string) slog.Handler {
+	return &LogHandler{handler: h.handler.WithGroup(name), itr: h.itr, progress: h.progress}
+}
+
+// progressAttr creates the group of attributes describing the
+// progress which was last displayed by the progress bar.
+func (h *LogHandler) progressAttr() slog.Attr {
+	mutex := h.itr.lock()
+	defer mutex.Unlock()
+
+	s := h.itr.snapshot()
+
+	return slog.Group("progress",
+		slog.Float64("percent", s.Percent),
+		slog.Float64("current", s.Current),
+		slog.Float64("total", s.Total),
+		slog.String("description", s.Description),
+	)
+}
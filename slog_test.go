@@ -0,0 +1,131 @@
+//go:build go1.21
+// +build go1.21
+
+/*
+ * The MIT License
+ *
+ * Copyright 2018 kinsey40.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * File:   slog_test.go
+ * Author: kinsey40
+ *
+ * Created on 19 October 2026, 11:35
+ *
+ * The test file for slog.go
+ *
+ */
+
+package pbar_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/kinsey40/pbar"
+	"github.com/kinsey40/pbar/internal/pbartest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTextLogHandler(t *testing.T) {
+	buffer := new(bytes.Buffer)
+	itr := pbartest.NewBar(t, buffer, pbartest.At(0), 3)
+	itr.Initialize()
+
+	buffer.Reset()
+	logger := slog.New(pbar.NewTextLogHandler(itr, &slog.HandlerOptions{
+		ReplaceAttr: func(_ []string, attr slog.Attr) slog.Attr {
+			if attr.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+
+			return attr
+		},
+	}))
+	logger.Info("Hello", "key", 1)
+
+	expected := "\r\033[Klevel=INFO msg=Hello key=1\n"
+	got := buffer.String()
+	assert.True(t, strings.HasPrefix(got, expected), fmt.Sprintf("Output incorrect expected prefix: %q; got: %q", expected, got))
+	assert.Contains(t, got, "0.0/3.0 0.0%", fmt.Sprintf("Bar not redrawn beneath the record: %q", got))
+}
+
+func TestLogHandlerProgress(t *testing.T) {
+	testCases := []struct {
+		progress        bool
+		updates         int
+		expectedCurrent float64
+		expectedPercent float64
+	}{
+		{false, 0, 0.0, 0.0},
+		{true, 0, 0.0, 0.0},
+		{true, 2, 2.0, 200.0 / 3.0},
+		{true, 3, 3.0, 100.0},
+	}
+
+	for _, testCase := range testCases {
+		itr := pbartest.NewBar(t, new(bytes.Buffer), pbartest.At(0), 3)
+		itr.SetDescription("Work")
+		itr.Initialize()
+		for index := 0; index < testCase.updates; index++ {
+			itr.Update()
+		}
+
+		records := new(bytes.Buffer)
+		handler := pbar.NewLogHandler(itr, slog.NewJSONHandler(records, nil))
+		handler.SetProgress(testCase.progress)
+		slog.New(handler).With("key", "value").WithGroup("group").Info("Hello")
+
+		var record map[string]interface{}
+		err := json.Unmarshal(records.Bytes(), &record)
+		assert.NoError(t, err, fmt.Sprintf("Unexpected error(%v) was raised!", err))
+
+		progress, ok := record["progress"].(map[string]interface{})
+		if !testCase.progress {
+			assert.False(t, ok, fmt.Sprintf("Progress attached when disabled: %v", record))
+			continue
+		}
+
+		if !ok {
+			group, _ := record["group"].(map[string]interface{})
+			progress, ok = group["progress"].(map[string]interface{})
+		}
+
+		assert.True(t, ok, fmt.Sprintf("Progress not attached: %v", record))
+		assert.Equal(t, "value", record["key"], fmt.Sprintf("Attributes not kept: %v", record))
+		assert.Equal(t, testCase.expectedCurrent, progress["current"], fmt.Sprintf("Current expected: %v; got: %v", testCase.expectedCurrent, progress["current"]))
+		assert.InDelta(t, testCase.expectedPercent, progress["percent"], 1e-9, fmt.Sprintf("Percent expected: %v; got: %v", testCase.expectedPercent, progress["percent"]))
+		assert.Equal(t, 3.0, progress["total"], fmt.Sprintf("Total expected: %v; got: %v", 3.0, progress["total"]))
+		assert.Equal(t, "Work", progress["description"], fmt.Sprintf("Description expected: %v; got: %v", "Work", progress["description"]))
+	}
+}
+
+func TestLogHandlerEnabled(t *testing.T) {
+	itr := pbartest.NewBar(t, new(bytes.Buffer), pbartest.At(0), 3)
+	handler := pbar.NewTextLogHandler(itr, &slog.HandlerOptions{Level: slog.LevelWarn})
+
+	assert.False(t, handler.Enabled(context.Background(), slog.LevelInfo), fmt.Sprintf("Info level enabled"))
+	assert.True(t, handler.Enabled(context.Background(), slog.LevelError), fmt.Sprintf("Error level not enabled"))
+}
@@ -0,0 +1,100 @@
+/*
+ * The MIT License
+ *
+ * Copyright 2018 kinsey40.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * File:   snapshot.go
+ * Author: kinsey40
+ *
+ * Created on 19 October 2026, 09:15
+ *
+ * Snapshot holds a consistent view of the progress of a progress bar, taken
+ * at the time of its last update.
+ *
+ */
+
+package pbar
+
+import (
+	"math"
+	"strings"
+	"time"
+)
+
+// Snapshot is the state of a progress bar at the time of its last update.
+// The ETA and Rate are only valid when Estimated is true, which is once
+// the first iteration has been completed. The Percent is the Current as a
+// percentage of the Total, as shown by the progress bar itself.
+type Snapshot struct {
+	Description string
+	Current     float64
+	Total       float64
+	Percent     float64
+	Elapsed     time.Duration
+	ETA         time.Duration
+	Rate        float64
+	Estimated   bool
+	State       State
+}
+
+// Snapshot returns the state of the progress bar at the time of its last
+// update. It is taken whilst holding the lock used by the updates, so it
+// is consistent even if the progress bar is being updated concurrently
+// (e.g. when serving the Snapshot from a health endpoint).
+func (itr *Iterator) Snapshot() Snapshot {
+	mutex := itr.lock()
+	defer mutex.Unlock()
+
+	return itr.snapshot()
+}
+
+// snapshot creates a Snapshot from the progress last displayed by
+// the progress bar. The lock must be held by the caller.
+func (itr *Iterator) snapshot() Snapshot {
+	start := itr.Values.GetStart()
+	stop := itr.Values.GetStop()
+	current := itr.shown
+	if current < start {
+		current = start
+	}
+
+	s := Snapshot{
+		Description: strings.TrimSuffix(itr.Settings.GetDescription(), ":"),
+		Current:     current,
+		Total:       stop,
+		Percent:     fraction(0.0, stop, current) * 100.0,
+		State:       itr.state,
+	}
+
+	if itr.Clock.IsStartTimeSet() != nil {
+		return s
+	}
+
+	elapsed, eta, rate, known := itr.Clock.Speed(start, stop, current)
+	s.Elapsed = elapsed
+	if known && !math.IsInf(rate, 0) && !math.IsNaN(rate) {
+		s.ETA = eta
+		s.Rate = rate
+		s.Estimated = true
+	}
+
+	return s
+}
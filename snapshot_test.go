@@ -0,0 +1,140 @@
+/*
+ * The MIT License
+ *
+ * Copyright 2018 kinsey40.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * File:   snapshot_test.go
+ * Author: kinsey40
+ *
+ * Created on 19 October 2026, 10:10
+ *
+ * The test file for snapshot.go
+ *
+ */
+
+package pbar_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kinsey40/pbar"
+	"github.com/kinsey40/pbar/internal/pbartest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSnapshot(t *testing.T) {
+	testCases := []struct {
+		initialize bool
+		updates    int
+		abort      bool
+		expected   pbar.Snapshot
+	}{
+		{false, 0, false, pbar.Snapshot{Description: "Work", Total: 4.0, State: pbar.Running}},
+		{true, 0, false, pbar.Snapshot{Description: "Work", Total: 4.0, Elapsed: time.Second, State: pbar.Running}},
+		{true, 1, false, pbar.Snapshot{"Work", 1.0, 4.0, 25.0, time.Second * 2, time.Second * 6, 0.5, true, pbar.Running}},
+		{true, 4, false, pbar.Snapshot{"Work", 4.0, 4.0, 100.0, time.Second * 5, 0, 0.8, true, pbar.Finished}},
+		{true, 2, true, pbar.Snapshot{"Work", 2.0, 4.0, 50.0, time.Second * 4, time.Second * 4, 0.5, true, pbar.Aborted}},
+		{false, 0, true, pbar.Snapshot{Description: "Work", Total: 4.0, State: pbar.Aborted}},
+	}
+
+	for _, testCase := range testCases {
+		itr := createJSONBar(t, new(bytes.Buffer), nil, 4)
+		itr.SetDescription("Work")
+		if testCase.initialize {
+			itr.Initialize()
+		}
+
+		for index := 0; index < testCase.updates; index++ {
+			itr.Update()
+		}
+
+		if testCase.abort {
+			itr.Abort(errors.New("Failed!"))
+		}
+
+		s := itr.Snapshot()
+		assert.Equal(t, testCase.expected, s, fmt.Sprintf("Snapshot incorrect expected: %+v; got: %+v", testCase.expected, s))
+	}
+}
+
+func TestSnapshotConcurrent(t *testing.T) {
+	itr := pbartest.NewBar(t, new(bytes.Buffer), pbartest.At(0), 3)
+	itr.Values.SetStop(200.0)
+	itr.Initialize()
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		previous := pbar.Snapshot{}
+		for {
+			s := itr.Snapshot()
+			assert.True(t, s.Current >= previous.Current, fmt.Sprintf("Current decreased from: %v; to: %v", previous.Current, s.Current))
+			assert.Equal(t, s.Current/2.0, s.Percent, fmt.Sprintf("Percent inconsistent with current: %+v", s))
+			if s.State == pbar.Finished {
+				return
+			}
+
+			previous = s
+		}
+	}()
+
+	for index := 0; index < 200; index++ {
+		itr.Update()
+	}
+	wg.Wait()
+}
+
+func TestSnapshotPercent(t *testing.T) {
+	testCases := []struct {
+		values   []interface{}
+		updates  int
+		expected float64
+	}{
+		{[]interface{}{5, 10}, 0, 50.0},
+		{[]interface{}{4, 10, 2}, 1, 60.0},
+		{[]interface{}{10}, 3, 30.0},
+	}
+
+	for _, testCase := range testCases {
+		buffer := new(bytes.Buffer)
+		itr := createJSONBar(t, buffer, nil, testCase.values...)
+		itr.SetTitle(true)
+		itr.Initialize()
+		for index := 0; index < testCase.updates; index++ {
+			itr.Update()
+		}
+
+		s := itr.Snapshot()
+		title := fmt.Sprintf("\033]0;%.0f%%", testCase.expected)
+		line := fmt.Sprintf("%.1f%%", testCase.expected)
+		assert.Equal(t, testCase.expected, s.Percent, fmt.Sprintf("Percent expected: %v; got: %v for: %v", testCase.expected, s.Percent, testCase.values))
+		assert.Contains(t, buffer.String(), title, fmt.Sprintf("Title percent incorrect expected: %q; got: %q", title, buffer.String()))
+		assert.Contains(t, buffer.String(), line, fmt.Sprintf("Line percent incorrect expected: %q; got: %q", line, buffer.String()))
+		itr.Abort(nil)
+	}
+}
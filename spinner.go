@@ -0,0 +1,422 @@
+/*
+ * The MIT License
+ *
+ * Copyright 2018 kinsey40.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * File:   spinner.go
+ * Author: kinsey40
+ *
+ * Created on 19 October 2026, 10:45
+ *
+ * Spinner provides an indicator for work whose size is not known in advance.
+ * It shares the Write, Clock and Settings objects with the progress bar, so the
+ * description and theme are handled in the same way.
+ *
+ */
+
+package pbar
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kinsey40/pbar/render"
+)
+
+// SpinnerFrames holds the built-in sets of frames for the spinner
+var SpinnerFrames = map[string][]string{
+	"line":    {"-", "\\", "|", "/"},
+	"dots":    {".  ", ".. ", "...", " ..", "  .", "   "},
+	"braille": {"\u280b", "\u2819", "\u2839", "\u2838", "\u283c", "\u2834", "\u2826", "\u2827", "\u2807", "\u280f"},
+	"arc":     {"\u25dc", "\u25e0", "\u25dd", "\u25de", "\u25e1", "\u25df"},
+	"moon":    {"\U0001f311", "\U0001f312", "\U0001f313", "\U0001f314", "\U0001f315", "\U0001f316", "\U0001f317", "\U0001f318"},
+}
+
+// The default values for the spinner
+var (
+	DefaultSpinnerFrames   = "line"
+	DefaultSpinnerInterval = time.Millisecond * 100
+	DefaultSuccessSymbol   = "\u2714"
+	DefaultFailureSymbol   = "\u2718"
+)
+
+// The ASCII equivalents of the default symbols, used when the
+// terminal cannot display Unicode (see render.SupportsUnicode)
+var (
+	ASCIISuccessSymbol = "+"
+	ASCIIFailureSymbol = "x"
+)
+
+// Spin enables the spinner execution.
+// To create a spinner, call the NewSpinner() function.
+// Start() the spinner before the work begins, then finish it with
+// either Success() or Failure() once the work is complete.
+type Spin interface {
+	Start() error
+	Success(string) error
+	Failure(string) error
+	SetDescription(string)
+	SetMessage(string)
+	SetFrames([]string) error
+	SetInterval(time.Duration)
+	SetSuccessSymbol(string)
+	SetFailureSymbol(string)
+	SetTaskbar(bool)
+	WithContext(context.Context)
+	Err() error
+
+	tick() error
+}
+
+// Spinner stores the relevant parameters associated with
+// the spinner, this is returned by the NewSpinner function.
+type Spinner struct {
+	Clock         render.Clock
+	Settings      render.Settings
+	Write         render.Write
+	Frames        []string
+	Interval      time.Duration
+	SuccessSymbol string
+	FailureSymbol string
+
+	mutex   sync.Mutex
+	ctx     context.Context
+	err     error
+	message string
+	frame   int
+	taskbar render.TaskbarState
+	stop    chan struct{}
+	done    chan struct{}
+}
+
+// NewSpinner creates a spinner using the named set of frames from
+// SpinnerFrames (one of: "line", "dots", "braille", "arc" or "moon").
+func NewSpinner(frames string) (Spin, error) {
+	spinnerFrames, ok := SpinnerFrames[frames]
+	if !ok {
+		return nil, fmt.Errorf("Frames: %q do not exist, expected one of: %v", frames, spinnerFrameNames())
+	}
+
+	s := new(Spinner)
+	s.Clock = render.NewClock()
+	s.Settings = render.NewSettings()
+	s.Write = render.NewWrite()
+	s.Frames = spinnerFrames
+	s.Interval = DefaultSpinnerInterval
+	s.SuccessSymbol = DefaultSuccessSymbol
+	s.FailureSymbol = DefaultFailureSymbol
+	if !render.SupportsUnicode() {
+		s.SuccessSymbol = ASCIISuccessSymbol
+		s.FailureSymbol = ASCIIFailureSymbol
+	}
+
+	return s, nil
+}
+
+// Start sets the internal timer to start and begins
+// animating the spinner in the background.
+func (s *Spinner) Start() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.stop != nil {
+		return errors.New("Spinner has already been started!")
+	}
+
+	if len(s.Frames) == 0 {
+		return errors.New("Spinner has no frames!")
+	}
+
+	s.Clock.SetStartTime()
+	if s.Settings.GetColourMode() == render.ColourAuto && s.Write != nil {
+		s.Settings.SetColourMode(render.DetectColourMode(s.Write.GetWriter()))
+	}
+
+	s.stop = make(chan struct{})
+	s.done = make(chan struct{})
+	s.taskbar = render.TaskbarIndeterminate
+	if err := s.render(s.Frames[0]); err != nil {
+		return err
+	}
+
+	var cancelled <-chan struct{}
+	if s.ctx != nil {
+		cancelled = s.ctx.Done()
+	}
+
+	go s.run(s.stop, cancelled, s.done)
+
+	return nil
+}
+
+// Success stops the spinner, displaying the success symbol
+// in place of the frames. If the message is not empty, it
+// replaces the current message.
+func (s *Spinner) Success(message string) error {
+	return s.finish(s.SuccessSymbol, message, render.TaskbarHidden)
+}
+
+// Failure stops the spinner, displaying the failure symbol
+// in place of the frames. If the message is not empty, it
+// replaces the current message.
+func (s *Spinner) Failure(message string) error {
+	return s.finish(s.FailureSymbol, message, render.TaskbarError)
+}
+
+// SetDescription sets the Description parameter, which is
+// displayed before the spinner.
+//
+// Default Value: ""
+func (s *Spinner) SetDescription(descrip string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.Settings.SetDescription(descrip)
+}
+
+// SetMessage sets the text displayed after the spinner, this
+// can be updated whilst the spinner is running.
+//
+// Default Value: ""
+func (s *Spinner) SetMessage(message string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.message = message
+	if s.stop != nil {
+		s.render(s.Frames[s.frame])
+	}
+}
+
+// SetFrames sets the frames that the spinner cycles through,
+// there must be at least one frame.
+//
+// Default Value: SpinnerFrames["line"]
+func (s *Spinner) SetFrames(frames []string) error {
+	if len(frames) == 0 {
+		return errors.New("Spinner must have at least one frame!")
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.Frames = frames
+	s.frame = 0
+
+	return nil
+}
+
+// SetInterval sets the time between each frame of the spinner,
+// it should be set before the spinner is started.
+//
+// Default Value: 100ms
+func (s *Spinner) SetInterval(interval time.Duration) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.Interval = interval
+}
+
+// SetSuccessSymbol sets the symbol displayed when the spinner
+// finishes successfully.
+//
+// Default Value: "✔"
+func (s *Spinner) SetSuccessSymbol(symbol string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.SuccessSymbol = symbol
+}
+
+// SetFailureSymbol sets the symbol displayed when the spinner
+// finishes unsuccessfully.
+//
+// Default Value: "✘"
+func (s *Spinner) SetFailureSymbol(symbol string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.FailureSymbol = symbol
+}
+
+// SetTaskbar sets whether the spinner is also displayed in the tab or
+// taskbar of the terminal, as indeterminate progress. Once the spinner
+// finishes the progress is removed, or set to the error state on Failure.
+//
+// Default Value: false
+func (s *Spinner) SetTaskbar(taskbar bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.Settings.SetTaskbar(taskbar)
+}
+
+// WithContext ties the spinner to the context, if the context is
+// cancelled the spinner stops, displaying the failure symbol and a
+// "cancelled" message. It should be called before Start.
+//
+// Default Value: nil (the spinner is never cancelled)
+func (s *Spinner) WithContext(ctx context.Context) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.ctx = ctx
+}
+
+// run advances the spinner every interval, until stop is closed
+// or the context is cancelled.
+func (s *Spinner) run(stop, cancelled <-chan struct{}, done chan<- struct{}) {
+	defer close(done)
+
+	ticker := time.NewTicker(s.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-cancelled:
+			s.cancel()
+			return
+		case <-ticker.C:
+			s.tick()
+		}
+	}
+}
+
+// tick moves the spinner forward by one frame
+func (s *Spinner) tick() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if len(s.Frames) == 0 {
+		return errors.New("Spinner has no frames!")
+	}
+
+	s.frame = (s.frame + 1) % len(s.Frames)
+
+	return s.render(s.Frames[s.frame])
+}
+
+// finish stops the background animation and renders the final frame,
+// leaving the taskbar in the given state.
+func (s *Spinner) finish(symbol, message string, taskbar render.TaskbarState) error {
+	// stop is cleared whilst the lock is held, so that
+	// only one caller closes it.
+	s.mutex.Lock()
+	stop, done, err := s.stop, s.done, s.err
+	s.stop = nil
+	s.mutex.Unlock()
+
+	if stop == nil {
+		if err != nil {
+			return err
+		} else if done != nil {
+			return errors.New("Spinner has already finished!")
+		}
+
+		return errors.New("You must call Start before finishing the Spinner!")
+	}
+
+	close(stop)
+	<-done
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.taskbar = taskbar
+	if message != "" {
+		s.message = message
+	}
+
+	if err := s.render(symbol); err != nil {
+		return err
+	}
+
+	return s.Write.WriteString(s.Settings.GetSuffix())
+}
+
+// cancel stops the spinner once the context has been cancelled,
+// rendering the failure symbol with the AbortedMessage.
+func (s *Spinner) cancel() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.stop = nil
+	s.err = s.ctx.Err()
+	s.message = AbortedMessage
+	s.taskbar = render.TaskbarError
+	if err := s.render(s.FailureSymbol); err != nil {
+		return err
+	}
+
+	return s.Write.WriteString(s.Settings.GetSuffix())
+}
+
+// Err returns the error of the context which cancelled the spinner
+func (s *Spinner) Err() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.err
+}
+
+// render writes the spinner line, with the given frame, to the writer
+func (s *Spinner) render(frame string) error {
+	if s.Write == nil {
+		return errors.New("Write is nil!")
+	}
+
+	s.Clock.Now()
+	elapsed := fmt.Sprintf("[elapsed: %s]", s.Clock.Format(s.Clock.Subtract()))
+	parts := []string{frame}
+	if description := s.Settings.GetDescription(); description != render.DefaultDescription {
+		parts = []string{s.Settings.Paint(render.DescriptionSegment, description), frame}
+	}
+
+	if s.message != "" {
+		parts = append(parts, s.message)
+	}
+
+	parts = append(parts, s.Settings.Paint(render.ETASegment, elapsed))
+	line := fmt.Sprintf("\r%s\033[K", strings.Join(parts, " "))
+	if s.Settings.GetTaskbar() {
+		line = render.TaskbarSequence(s.taskbar, 0.0) + line
+	}
+
+	return s.Write.WriteString(line)
+}
+
+// spinnerFrameNames returns the names of the built-in frame sets, in order
+func spinnerFrameNames() []string {
+	names := make([]string, 0, len(SpinnerFrames))
+	for name := range SpinnerFrames {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
@@ -0,0 +1,92 @@
+/*
+ * The MIT License
+ *
+ * Copyright 2018 kinsey40.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * File:   spinner_internal_test.go
+ * Author: kinsey40
+ *
+ * Created on 19 October 2026, 10:45
+ *
+ * The internal test file for spinner.go
+ *
+ */
+
+package pbar
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/kinsey40/pbar/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpinnerTick(t *testing.T) {
+	testCases := []struct {
+		frames         []string
+		ticks          int
+		message        string
+		expectError    bool
+		expectedOutput string
+	}{
+		{[]string{"a", "b", "c"}, 1, "", false, "\rb [elapsed: 00m:01s]\033[K"},
+		{[]string{"a", "b", "c"}, 3, "Working", false, "\ra Working [elapsed: 00m:01s]\033[K"},
+		{[]string{}, 1, "", true, ""},
+	}
+
+	for _, testCase := range testCases {
+		render.NowTime = func() time.Time { return time.Unix(1, 0) }
+		buffer := new(bytes.Buffer)
+		s := &Spinner{
+			Clock:    &render.ClockVal{StartTime: time.Unix(0, 0)},
+			Settings: &render.Set{},
+			Write:    &render.Writing{W: buffer},
+			Frames:   testCase.frames,
+			message:  testCase.message,
+		}
+
+		var err error
+		for i := 0; i < testCase.ticks; i++ {
+			buffer.Reset()
+			err = s.tick()
+		}
+
+		got := buffer.String()
+		if testCase.expectError {
+			assert.Error(t, err, fmt.Sprintf("Expected error not raised"))
+		} else {
+			assert.NoError(t, err, fmt.Sprintf("Unexpected error raised: %v", err))
+			assert.Equal(t, testCase.expectedOutput, got, fmt.Sprintf("Output incorrect expected: %q; got: %q", testCase.expectedOutput, got))
+		}
+	}
+}
+
+func TestSpinnerRenderWriteNil(t *testing.T) {
+	s := &Spinner{
+		Clock:    &render.ClockVal{},
+		Settings: &render.Set{},
+	}
+
+	assert.Error(t, s.render("a"), fmt.Sprintf("Expected error not raised"))
+}
@@ -0,0 +1,304 @@
+/*
+ * The MIT License
+ *
+ * Copyright 2018 kinsey40.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * File:   spinner_test.go
+ * Author: kinsey40
+ *
+ * Created on 19 October 2026, 10:45
+ *
+ * The test file for spinner.go
+ *
+ */
+
+package pbar_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kinsey40/pbar"
+	"github.com/kinsey40/pbar/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSpinner(t *testing.T) {
+	testCases := []struct {
+		frames      string
+		expectError bool
+	}{
+		{"line", false},
+		{"dots", false},
+		{"braille", false},
+		{"arc", false},
+		{"moon", false},
+		{"Hello", true},
+	}
+
+	for _, testCase := range testCases {
+		s, err := pbar.NewSpinner(testCase.frames)
+		if testCase.expectError {
+			assert.Error(t, err, fmt.Sprintf("Expected error was not raised!"))
+			assert.Nil(t, s, fmt.Sprintf("Spinner is not nil (%v)", s))
+		} else {
+			spinner := s.(*pbar.Spinner)
+			assert.NoError(t, err, fmt.Sprintf("Unexpected error(%v) was raised!", err))
+			assert.Equal(t, pbar.SpinnerFrames[testCase.frames], spinner.Frames, fmt.Sprintf("Frames incorrect for: %v", testCase.frames))
+			assert.Equal(t, pbar.DefaultSpinnerInterval, spinner.Interval, fmt.Sprintf("Interval incorrect"))
+		}
+	}
+}
+
+func TestNewSpinnerUnicode(t *testing.T) {
+	testCases := []struct {
+		env             map[string]string
+		expectedSuccess string
+		expectedFailure string
+	}{
+		{map[string]string{"TERM": "xterm", "LANG": "en_GB.UTF-8"}, pbar.DefaultSuccessSymbol, pbar.DefaultFailureSymbol},
+		{map[string]string{}, pbar.DefaultSuccessSymbol, pbar.DefaultFailureSymbol},
+		{map[string]string{"TERM": "dumb"}, pbar.ASCIISuccessSymbol, pbar.ASCIIFailureSymbol},
+		{map[string]string{"LANG": "C"}, pbar.ASCIISuccessSymbol, pbar.ASCIIFailureSymbol},
+	}
+	defer func() { render.LookupEnv = os.LookupEnv }()
+
+	for _, testCase := range testCases {
+		env := testCase.env
+		render.LookupEnv = func(key string) (string, bool) {
+			value, ok := env[key]
+			return value, ok
+		}
+
+		s, _ := pbar.NewSpinner(pbar.DefaultSpinnerFrames)
+		spinner := s.(*pbar.Spinner)
+		assert.Equal(t, testCase.expectedSuccess, spinner.SuccessSymbol, fmt.Sprintf("Success symbol incorrect for: %v", env))
+		assert.Equal(t, testCase.expectedFailure, spinner.FailureSymbol, fmt.Sprintf("Failure symbol incorrect for: %v", env))
+	}
+}
+
+func TestSpinnerLifecycle(t *testing.T) {
+	testCases := []struct {
+		description    string
+		message        string
+		success        bool
+		finalMessage   string
+		expectedSuffix string
+	}{
+		{"", "", true, "", "\r+ [elapsed: 00m:00s]\033[K\n"},
+		{"Hello", "Working", true, "Done", "\rHello: + Done [elapsed: 00m:00s]\033[K\n"},
+		{"Hello", "Working", false, "", "\rHello: x Working [elapsed: 00m:00s]\033[K\n"},
+	}
+
+	for _, testCase := range testCases {
+		render.NowTime = func() time.Time { return time.Unix(2, 0) }
+		buffer := new(bytes.Buffer)
+		s := &pbar.Spinner{
+			Clock:         &render.ClockVal{},
+			Settings:      &render.Set{Suffix: "\n"},
+			Write:         &render.Writing{W: buffer},
+			Frames:        []string{"-"},
+			Interval:      time.Hour,
+			SuccessSymbol: "+",
+			FailureSymbol: "x",
+		}
+
+		s.SetDescription(testCase.description)
+		s.SetMessage(testCase.message)
+		assert.NoError(t, s.Start(), fmt.Sprintf("Unexpected error raised on Start"))
+		assert.Error(t, s.Start(), fmt.Sprintf("Expected error not raised on second Start"))
+
+		var err error
+		if testCase.success {
+			err = s.Success(testCase.finalMessage)
+		} else {
+			err = s.Failure(testCase.finalMessage)
+		}
+
+		got := buffer.String()
+		assert.NoError(t, err, fmt.Sprintf("Unexpected error raised: %v", err))
+		assert.True(
+			t,
+			strings.HasSuffix(got, testCase.expectedSuffix),
+			fmt.Sprintf("Output incorrect expected suffix: %q; got: %q", testCase.expectedSuffix, got),
+		)
+	}
+}
+
+func TestSpinnerFinishBeforeStart(t *testing.T) {
+	s, _ := pbar.NewSpinner("line")
+
+	assert.Error(t, s.Success(""), fmt.Sprintf("Expected error not raised"))
+	assert.Error(t, s.Failure(""), fmt.Sprintf("Expected error not raised"))
+}
+
+func TestSpinnerStartWithoutFrames(t *testing.T) {
+	s, _ := pbar.NewSpinner("line")
+	s.(*pbar.Spinner).Frames = nil
+
+	assert.Error(t, s.Start(), fmt.Sprintf("Expected error not raised"))
+}
+
+func TestSpinnerSetFrames(t *testing.T) {
+	testCases := []struct {
+		frames      []string
+		expectError bool
+	}{
+		{[]string{"a"}, false},
+		{[]string{"a", "b"}, false},
+		{[]string{}, true},
+		{nil, true},
+	}
+
+	for _, testCase := range testCases {
+		s, _ := pbar.NewSpinner("line")
+		s.(*pbar.Spinner).Write = &render.Writing{W: new(bytes.Buffer)}
+		s.Start()
+		err := s.SetFrames(testCase.frames)
+		s.SetMessage("Hello")
+		s.Success("")
+
+		frames := s.(*pbar.Spinner).Frames
+		if testCase.expectError {
+			assert.Error(t, err, fmt.Sprintf("Expected error not raised for: %q", testCase.frames))
+			assert.Equal(t, pbar.SpinnerFrames["line"], frames, fmt.Sprintf("Frames changed to: %q", frames))
+		} else {
+			assert.NoError(t, err, fmt.Sprintf("Unexpected error(%v) raised for: %q", err, testCase.frames))
+			assert.Equal(t, testCase.frames, frames, fmt.Sprintf("Frames expected: %q; got: %q", testCase.frames, frames))
+		}
+	}
+}
+
+func TestSpinnerFinishConcurrent(t *testing.T) {
+	s, _ := pbar.NewSpinner("line")
+	s.(*pbar.Spinner).Write = &render.Writing{W: new(bytes.Buffer)}
+	s.Start()
+
+	errs := make(chan error, 4)
+	var wg sync.WaitGroup
+	for index := 0; index < 4; index++ {
+		wg.Add(1)
+		go func(index int) {
+			defer wg.Done()
+			if index%2 == 0 {
+				errs <- s.Success("")
+			} else {
+				errs <- s.Failure("")
+			}
+		}(index)
+	}
+	wg.Wait()
+	close(errs)
+
+	failed := 0
+	for err := range errs {
+		if err != nil {
+			failed++
+		}
+	}
+
+	assert.Equal(t, 3, failed, fmt.Sprintf("Spinner finished more than once: %v", 4-failed))
+}
+
+func TestSpinnerSetters(t *testing.T) {
+	s, _ := pbar.NewSpinner("line")
+	s.SetFrames([]string{"a", "b"})
+	s.SetInterval(time.Second)
+	s.SetSuccessSymbol("yes")
+	s.SetFailureSymbol("no")
+	s.SetDescription("Hello")
+	spinner := s.(*pbar.Spinner)
+
+	assert.Equal(t, []string{"a", "b"}, spinner.Frames, fmt.Sprintf("Frames incorrect: %v", spinner.Frames))
+	assert.Equal(t, time.Second, spinner.Interval, fmt.Sprintf("Interval incorrect: %v", spinner.Interval))
+	assert.Equal(t, "yes", spinner.SuccessSymbol, fmt.Sprintf("SuccessSymbol incorrect: %v", spinner.SuccessSymbol))
+	assert.Equal(t, "no", spinner.FailureSymbol, fmt.Sprintf("FailureSymbol incorrect: %v", spinner.FailureSymbol))
+	assert.Equal(t, "Hello:", spinner.Settings.GetDescription(), fmt.Sprintf("Description incorrect: %v", spinner.Settings.GetDescription()))
+}
+
+func TestSpinnerCancelled(t *testing.T) {
+	render.NowTime = func() time.Time { return time.Unix(0, 0) }
+	ctx, cancel := context.WithCancel(context.Background())
+	buffer := new(bytes.Buffer)
+	s := &pbar.Spinner{
+		Clock:         &render.ClockVal{},
+		Settings:      &render.Set{Suffix: "\n"},
+		Write:         &render.Writing{W: buffer},
+		Frames:        []string{"-"},
+		Interval:      time.Hour,
+		SuccessSymbol: "+",
+		FailureSymbol: "x",
+	}
+
+	s.WithContext(ctx)
+	s.Start()
+	cancel()
+	assert.Eventually(t, func() bool { return s.Err() != nil }, time.Second, time.Millisecond)
+
+	expectedSuffix := "\rx cancelled [elapsed: 00m:00s]\033[K\n"
+	got := buffer.String()
+	assert.True(t, strings.HasSuffix(got, expectedSuffix), fmt.Sprintf("Output incorrect expected suffix: %q; got: %q", expectedSuffix, got))
+	assert.Equal(t, context.Canceled, s.Success("Done"), fmt.Sprintf("Success after cancel did not return the error"))
+}
+
+func TestSpinnerTaskbar(t *testing.T) {
+	testCases := []struct {
+		success         bool
+		expectedFinal   string
+		expectedRunning string
+	}{
+		{true, "\033]9;4;0;0\007", "\033]9;4;3;0\007"},
+		{false, "\033]9;4;2;0\007", "\033]9;4;3;0\007"},
+	}
+
+	for _, testCase := range testCases {
+		render.NowTime = func() time.Time { return time.Unix(2, 0) }
+		buffer := new(bytes.Buffer)
+		s := &pbar.Spinner{
+			Clock:         &render.ClockVal{},
+			Settings:      &render.Set{Suffix: "\n"},
+			Write:         &render.Writing{W: buffer},
+			Frames:        []string{"-"},
+			Interval:      time.Hour,
+			SuccessSymbol: "+",
+			FailureSymbol: "x",
+		}
+
+		s.SetTaskbar(true)
+		s.Start()
+		running := buffer.String()
+		if testCase.success {
+			s.Success("")
+		} else {
+			s.Failure("")
+		}
+		final := strings.TrimPrefix(buffer.String(), running)
+
+		assert.True(t, strings.HasPrefix(running, testCase.expectedRunning), fmt.Sprintf("Running sequence incorrect expected: %q; got: %q", testCase.expectedRunning, running))
+		assert.True(t, strings.HasPrefix(final, testCase.expectedFinal), fmt.Sprintf("Final sequence incorrect expected: %q; got: %q", testCase.expectedFinal, final))
+	}
+}
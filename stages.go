@@ -0,0 +1,339 @@
+/*
+ * The MIT License
+ *
+ * Copyright 2018 kinsey40.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * File:   stages.go
+ * Author: kinsey40
+ *
+ * Created on 19 October 2026, 13:50
+ *
+ * Stages enables a progress bar to be made up of several stages, each with
+ * its own total and weight. The overall percentage and time remaining are
+ * calculated from the weighted progress of the stages.
+ *
+ */
+
+package pbar
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/kinsey40/pbar/render"
+)
+
+// Stage describes a single stage of a staged progress bar. The Weight is
+// the cost of the stage relative to the other stages, a Weight of zero is
+// treated as one.
+type Stage struct {
+	Name   string
+	Total  float64
+	Weight float64
+}
+
+// Staged enables the staged progress bar execution. Update moves the
+// current stage forward by one step, once a stage is complete the
+// next stage is started automatically.
+type Staged interface {
+	Iterate
+	NextStage() error
+	CurrentStage() (int, Stage)
+}
+
+// StagedIterator stores the stages and the progress through them,
+// alongside the Iterator used to render the overall progress.
+// This is returned by the Stages function.
+type StagedIterator struct {
+	*Iterator
+	StageValues render.Values
+
+	stages          []Stage
+	stage           int
+	weightCompleted float64
+	stageStarted    time.Duration
+}
+
+// StagedMinimumLineSize is the smallest number of characters used to draw
+// the overall progress of a staged progress bar, as the current stage is
+// described alongside the statistics.
+var StagedMinimumLineSize = 10
+
+// Stages creates a progress bar from a list of stages, which are
+// performed in order.
+func Stages(stages ...Stage) (Staged, error) {
+	if len(stages) == 0 {
+		return nil, errors.New("Must pass at least one stage!")
+	}
+
+	totalWeight := 0.0
+	copied := make([]Stage, len(stages))
+	for index, stage := range stages {
+		if stage.Total <= 0 {
+			return nil, fmt.Errorf("Stage: %q must have a Total greater than zero!", stage.Name)
+		}
+
+		if stage.Weight < 0 {
+			return nil, fmt.Errorf("Stage: %q must not have a negative Weight!", stage.Name)
+		}
+
+		if stage.Weight == 0 {
+			stage.Weight = 1.0
+		}
+
+		totalWeight += stage.Weight
+		copied[index] = stage
+	}
+
+	s := &StagedIterator{
+		Iterator:    makeIteratorObject().(*Iterator),
+		StageValues: render.NewValues(),
+		stages:      copied,
+	}
+
+	// The overall current value is set directly from the weighted progress
+	// of the stages, rather than by stepping.
+	s.Values.SetStop(totalWeight)
+	s.Values.SetStep(0.0)
+	s.startStage(0)
+
+	return s, nil
+}
+
+// Initialize sets the internal timer to start and renders
+// the first stage of the progress bar.
+func (s *StagedIterator) Initialize() error {
+	mutex := s.lock()
+	err := s.start()
+	if err == nil {
+		lineSize := s.Settings.GetLineSize() - s.stagePrefixSize()
+		if lineSize < StagedMinimumLineSize {
+			lineSize = StagedMinimumLineSize
+		}
+
+		s.Settings.SetLineSize(lineSize)
+	}
+	mutex.Unlock()
+	if err != nil {
+		return err
+	}
+
+	if err := s.Update(); err != nil {
+		return err
+	}
+
+	s.watch()
+
+	return nil
+}
+
+// Update moves the current stage forward by one step. This should
+// be performed at the end of each iteration of the current stage.
+func (s *StagedIterator) Update() error {
+	mutex := s.lock()
+	defer s.unlock(mutex)
+
+	if err := s.Clock.IsStartTimeSet(); err != nil {
+		panic(err)
+	}
+
+	if s.state == Aborted {
+		return s.err
+	}
+
+	s.Clock.Now()
+
+	return s.progress()
+}
+
+// Next moves the current stage forward and reports whether there is
+// another iteration to perform, the first call Initializes the progress bar.
+func (s *StagedIterator) Next() bool {
+	return s.next(s.Initialize, s.Update)
+}
+
+// NextStage completes the current stage, regardless of its progress,
+// and moves onto the next one.
+func (s *StagedIterator) NextStage() error {
+	mutex := s.lock()
+	defer s.unlock(mutex)
+
+	if s.stage >= len(s.stages)-1 {
+		return errors.New("There are no further stages!")
+	}
+
+	if err := s.Clock.IsStartTimeSet(); err != nil {
+		return err
+	}
+
+	if s.state != Running {
+		return errors.New("The progress bar is no longer running!")
+	}
+
+	s.Clock.Now()
+
+	return s.advance()
+}
+
+// CurrentStage returns the index and details of the current stage
+func (s *StagedIterator) CurrentStage() (int, Stage) {
+	mutex := s.lock()
+	defer mutex.Unlock()
+
+	return s.stage, s.stages[s.stage]
+}
+
+// Add is not supported by a staged progress bar, the
+// stages are moved forward using Update and NextStage.
+func (s *StagedIterator) Add(n float64) error {
+	return errors.New("Cannot Add to a staged progress bar!")
+}
+
+// SetTotal is not supported by a staged progress bar,
+// the totals are set by the stages.
+func (s *StagedIterator) SetTotal(total float64) error {
+	return errors.New("Cannot set the total of a staged progress bar!")
+}
+
+// Child is not supported by a staged progress bar
+func (s *StagedIterator) Child(total interface{}, description string) (Iterate, error) {
+	return nil, errors.New("Cannot create a child of a staged progress bar!")
+}
+
+// progress renders the current state of the stages, and then moves the
+// current stage forward, starting the next stage once it is complete.
+func (s *StagedIterator) progress() error {
+	current := s.StageValues.GetCurrent()
+	stop := s.StageValues.GetStop()
+	if current > stop {
+		return fmt.Errorf("Current: %f is incorrect for stage: %q. Stop: %f", current, s.stages[s.stage].Name, stop)
+	}
+
+	if current == stop && s.stage == len(s.stages)-1 {
+		s.setState(Finished)
+	}
+
+	if err := s.renderLine(s.formatStagedBar()); err != nil {
+		return err
+	}
+
+	if current < stop {
+		s.StageValues.SetCurrent(current + s.StageValues.GetStep())
+		return nil
+	}
+
+	if s.stage < len(s.stages)-1 {
+		return s.advance()
+	}
+
+	s.StageValues.SetCurrent(current + s.StageValues.GetStep())
+
+	return s.renderSuffix()
+}
+
+// advance completes the current stage and renders the start of the
+// next stage.
+func (s *StagedIterator) advance() error {
+	s.weightCompleted += s.stages[s.stage].Weight
+	s.startStage(s.stage + 1)
+	if err := s.renderLine(s.formatStagedBar()); err != nil {
+		return err
+	}
+
+	s.StageValues.SetCurrent(s.StageValues.GetStep())
+
+	return nil
+}
+
+// stagePrefixSize finds the number of characters needed to display
+// the overall percentage and the longest stage name.
+func (s *StagedIterator) stagePrefixSize() int {
+	longest := ""
+	for _, stage := range s.stages {
+		if len(stage.Name) > len(longest) {
+			longest = stage.Name
+		}
+	}
+
+	return len(fmt.Sprintf("100.0%% [stage %d/%d: %s ]", len(s.stages), len(s.stages), longest))
+}
+
+// startStage resets the stage values ready for the given stage
+func (s *StagedIterator) startStage(index int) {
+	s.stage = index
+	s.StageValues.SetStart(0.0)
+	s.StageValues.SetCurrent(0.0)
+	s.StageValues.SetStop(s.stages[index].Total)
+	s.StageValues.SetStep(1.0)
+	if s.Clock.IsStartTimeSet() == nil {
+		s.stageStarted = s.Clock.Subtract()
+	}
+}
+
+// formatStagedBar creates the progress bar for the overall weighted
+// progress, followed by the name and statistics of the current stage.
+func (s *StagedIterator) formatStagedBar() string {
+	stage := s.stages[s.stage]
+	lineSize := s.Settings.GetLineSize()
+	stageStats, _ := s.StageValues.Statistics(lineSize)
+	stageFraction := fraction(0.0, s.StageValues.GetStop(), s.StageValues.GetCurrent())
+
+	overall := s.weightCompleted + stage.Weight*stageFraction
+	s.Values.SetCurrent(overall)
+	s.shown = overall
+	overallFraction := fraction(0.0, s.Values.GetStop(), overall)
+	s.Settings.SetPercentage(overallFraction * 100.0)
+
+	barString := s.Settings.CreateBarString(int(overallFraction * float64(lineSize)))
+	statistics := fmt.Sprintf("%.1f%% [stage %d/%d: %s %s]", overallFraction*100.0, s.stage+1, len(s.stages), stage.Name, stageStats)
+	speedMeter := s.createStagedSpeedMeter(overallFraction)
+
+	return strings.Join([]string{
+		barString,
+		s.Settings.Paint(render.StatisticsSegment, statistics),
+		s.Settings.Paint(render.ETASegment, speedMeter),
+	}, " ")
+}
+
+// createStagedSpeedMeter forms the elapsed and remaining time from the
+// overall weighted fraction, and the rate of iterations for the current
+// stage.
+func (s *StagedIterator) createStagedSpeedMeter(overallFraction float64) string {
+	elapsed := s.Clock.Subtract()
+	stageElapsed := elapsed - s.stageStarted
+	current := s.StageValues.GetCurrent()
+	left := "N/A"
+	rate := "N/A"
+
+	if overallFraction > 0 && elapsed > 0 {
+		remaining := s.Clock.Remaining(math.Round(elapsed.Seconds() * (1.0 - overallFraction) / overallFraction))
+		left = s.Clock.Format(remaining)
+	}
+
+	if current > 0 && stageElapsed > 0 {
+		rate = fmt.Sprintf("%.2f", current/stageElapsed.Seconds())
+	}
+
+	return fmt.Sprintf("[elapsed: %s, left: %s, %s iters/sec]", s.Clock.Format(elapsed), left, rate)
+}
@@ -0,0 +1,284 @@
+/*
+ * The MIT License
+ *
+ * Copyright 2018 kinsey40.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * File:   stages_test.go
+ * Author: kinsey40
+ *
+ * Created on 19 October 2026, 16:10
+ *
+ * The test file for stages.go
+ *
+ */
+
+package pbar_test
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kinsey40/pbar"
+	"github.com/kinsey40/pbar/internal/pbartest"
+	"github.com/kinsey40/pbar/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func createStaged(t *testing.T, buffer *bytes.Buffer, stages ...pbar.Stage) *pbar.StagedIterator {
+	staged, err := pbar.Stages(stages...)
+	assert.NoError(t, err, fmt.Sprintf("Unexpected error(%v) was raised!", err))
+
+	s := staged.(*pbar.StagedIterator)
+	s.Write = &render.Writing{W: buffer}
+	s.SetDescription("ETL")
+
+	return s
+}
+
+func TestStages(t *testing.T) {
+	testCases := []struct {
+		stages      []pbar.Stage
+		totalWeight float64
+		expectError bool
+	}{
+		{[]pbar.Stage{{"download", 10, 1}, {"parse", 20, 3}}, 4.0, false},
+		{[]pbar.Stage{{"download", 10, 0}, {"parse", 20, 0}}, 2.0, false},
+		{[]pbar.Stage{{"download", 10, 0.5}}, 0.5, false},
+		{[]pbar.Stage{}, 0.0, true},
+		{[]pbar.Stage{{"download", 0, 1}}, 0.0, true},
+		{[]pbar.Stage{{"download", 10, -1}}, 0.0, true},
+	}
+
+	for _, testCase := range testCases {
+		staged, err := pbar.Stages(testCase.stages...)
+		if testCase.expectError {
+			assert.Error(t, err, fmt.Sprintf("Expected error was not raised!"))
+			assert.Nil(t, staged, fmt.Sprintf("Staged is not nil (%v)", staged))
+		} else {
+			s := staged.(*pbar.StagedIterator)
+			index, stage := s.CurrentStage()
+			assert.NoError(t, err, fmt.Sprintf("Unexpected error(%v) was raised!", err))
+			assert.Equal(t, testCase.totalWeight, s.Values.GetStop(), fmt.Sprintf("Total weight expected: %v; got: %v", testCase.totalWeight, s.Values.GetStop()))
+			assert.Equal(t, 0, index, fmt.Sprintf("Stage index expected: %v; got: %v", 0, index))
+			assert.Equal(t, testCase.stages[0].Name, stage.Name, fmt.Sprintf("Stage expected: %v; got: %v", testCase.stages[0].Name, stage.Name))
+		}
+	}
+}
+
+func TestStagedLineSize(t *testing.T) {
+	testCases := []struct {
+		width        int
+		expectedBar  string
+		expectedLine string
+	}{
+		{80, "|##........|", "25.0% [stage 1/2: download 1.0/2.0 50.0%]"},
+		{100, "|##........|", "25.0% [stage 1/2: download 1.0/2.0 50.0%]"},
+		{150, "|" + strings.Repeat("#", 9) + strings.Repeat(".", 28) + "|", "25.0% [stage 1/2: download 1.0/2.0 50.0%]"},
+	}
+
+	for _, testCase := range testCases {
+		pbartest.Stub(t, testCase.width, pbartest.At(0))
+
+		buffer := new(bytes.Buffer)
+		s := createStaged(t, buffer, pbar.Stage{Name: "download", Total: 2}, pbar.Stage{Name: "load", Total: 2})
+		s.SetUnicode(false)
+		s.SetCurrentIterationSymbol("#")
+		s.SetRemainingIterationSymbol(".")
+		err := s.Initialize()
+		s.Update()
+
+		expected := fmt.Sprintf("ETL: %s %s", testCase.expectedBar, testCase.expectedLine)
+		assert.NoError(t, err, fmt.Sprintf("Unexpected error(%v) raised at width: %v", err, testCase.width))
+		assert.Contains(t, buffer.String(), expected, fmt.Sprintf("Line expected to contain: %q at width: %v; got: %q", expected, testCase.width, buffer.String()))
+	}
+}
+
+func TestStagedUnsupported(t *testing.T) {
+	pbartest.Stub(t, 150, pbartest.At(0))
+
+	buffer := new(bytes.Buffer)
+	s := createStaged(t, buffer, pbar.Stage{Name: "download", Total: 2, Weight: 1}, pbar.Stage{Name: "parse", Total: 4, Weight: 3})
+	s.Initialize()
+	buffer.Reset()
+
+	child, childErr := s.Child(2, "Child")
+	testCases := []struct {
+		name string
+		err  error
+	}{
+		{"Add", s.Add(1)},
+		{"SetTotal", s.SetTotal(10)},
+		{"Child", childErr},
+	}
+
+	for _, testCase := range testCases {
+		assert.Error(t, testCase.err, fmt.Sprintf("Expected error was not raised by: %v", testCase.name))
+	}
+
+	assert.Nil(t, child, fmt.Sprintf("Child is not nil (%v)", child))
+	assert.Empty(t, buffer.String(), fmt.Sprintf("Progress bar drawn: %q", buffer.String()))
+	assert.Equal(t, 4.0, s.Values.GetStop(), fmt.Sprintf("Total weight expected: %v; got: %v", 4.0, s.Values.GetStop()))
+	assert.Equal(t, 1.0, s.StageValues.GetCurrent(), fmt.Sprintf("Stage current expected: %v; got: %v", 1.0, s.StageValues.GetCurrent()))
+}
+
+func TestStagedProgress(t *testing.T) {
+	pbartest.Stub(t, 150, pbartest.At(0))
+
+	buffer := new(bytes.Buffer)
+	s := createStaged(t, buffer, pbar.Stage{Name: "download", Total: 2, Weight: 1}, pbar.Stage{Name: "parse", Total: 4, Weight: 3})
+	s.Initialize()
+
+	testCases := []struct {
+		stage   int
+		current float64
+		line    string
+	}{
+		{0, 0.5, "12.5% [stage 1/2: download 1.0/2.0 50.0%]"},
+		{1, 1.0, "25.0% [stage 2/2: parse 0.0/4.0 0.0%]"},
+		{1, 1.75, "43.8% [stage 2/2: parse 1.0/4.0 25.0%]"},
+		{1, 2.5, "62.5% [stage 2/2: parse 2.0/4.0 50.0%]"},
+		{1, 3.25, "81.2% [stage 2/2: parse 3.0/4.0 75.0%]"},
+		{1, 4.0, "100.0% [stage 2/2: parse 4.0/4.0 100.0%]"},
+	}
+
+	for _, testCase := range testCases {
+		buffer.Reset()
+		err := s.Update()
+		index, _ := s.CurrentStage()
+
+		assert.NoError(t, err, fmt.Sprintf("Unexpected error(%v) was raised!", err))
+		assert.Equal(t, testCase.stage, index, fmt.Sprintf("Stage expected: %v; got: %v", testCase.stage, index))
+		assert.Equal(t, testCase.current, s.Values.GetCurrent(), fmt.Sprintf("Overall current expected: %v; got: %v", testCase.current, s.Values.GetCurrent()))
+		assert.Contains(t, buffer.String(), testCase.line, fmt.Sprintf("Line expected to contain: %q; got: %q", testCase.line, buffer.String()))
+	}
+
+	assert.True(t, strings.HasSuffix(buffer.String(), "\r\n"), fmt.Sprintf("Suffix not written at the end: %q", buffer.String()))
+	assert.Error(t, s.Update(), fmt.Sprintf("Expected error was not raised!"))
+}
+
+func TestNextStage(t *testing.T) {
+	pbartest.Stub(t, 150, pbartest.At(0))
+
+	buffer := new(bytes.Buffer)
+	s := createStaged(t, buffer, pbar.Stage{Name: "download", Total: 10}, pbar.Stage{Name: "load", Total: 5})
+	assert.Error(t, s.NextStage(), fmt.Sprintf("Expected error was not raised before Initialize!"))
+
+	s.Initialize()
+	s.Update()
+
+	buffer.Reset()
+	err := s.NextStage()
+	index, stage := s.CurrentStage()
+	assert.NoError(t, err, fmt.Sprintf("Unexpected error(%v) was raised!", err))
+	assert.Equal(t, 1, index, fmt.Sprintf("Stage expected: %v; got: %v", 1, index))
+	assert.Equal(t, "load", stage.Name, fmt.Sprintf("Stage expected: %v; got: %v", "load", stage.Name))
+	assert.Contains(t, buffer.String(), "50.0% [stage 2/2: load 0.0/5.0 0.0%]", fmt.Sprintf("Next stage not rendered: %q", buffer.String()))
+
+	buffer.Reset()
+	s.Update()
+	assert.Contains(t, buffer.String(), "stage 2/2: load 1.0/5.0 20.0%", fmt.Sprintf("Update after next stage incorrect: %q", buffer.String()))
+	assert.Error(t, s.NextStage(), fmt.Sprintf("Expected error was not raised on the final stage!"))
+}
+
+func TestStagedSpeedMeter(t *testing.T) {
+	seconds := int64(0)
+	pbartest.Stub(t, 150, func() time.Time { return time.Unix(seconds, 0) })
+
+	buffer := new(bytes.Buffer)
+	s := createStaged(t, buffer, pbar.Stage{Name: "fast", Total: 2, Weight: 1}, pbar.Stage{Name: "slow", Total: 2, Weight: 3})
+	s.Initialize()
+
+	testCases := []struct {
+		seconds int64
+		meter   string
+	}{
+		{1, "[elapsed: 00m:01s, left: 00m:07s, 1.00 iters/sec]"},
+		{2, "[elapsed: 00m:02s, left: 00m:06s, N/A iters/sec]"},
+		{6, "[elapsed: 00m:06s, left: 00m:04s, 0.25 iters/sec]"},
+	}
+
+	for _, testCase := range testCases {
+		seconds = testCase.seconds
+		buffer.Reset()
+		s.Update()
+
+		assert.Contains(t, buffer.String(), testCase.meter, fmt.Sprintf("Speed meter expected: %q; got: %q", testCase.meter, buffer.String()))
+	}
+}
+
+func TestStagedNext(t *testing.T) {
+	pbartest.Stub(t, 150, pbartest.At(0))
+
+	buffer := new(bytes.Buffer)
+	s := createStaged(t, buffer, pbar.Stage{Name: "download", Total: 2}, pbar.Stage{Name: "load", Total: 3})
+
+	iterations := 0
+	for s.Next() {
+		iterations++
+	}
+
+	assert.Equal(t, 5, iterations, fmt.Sprintf("Iterations expected: %v; got: %v", 5, iterations))
+	assert.Contains(t, buffer.String(), "100.0% [stage 2/2: load 3.0/3.0 100.0%]", fmt.Sprintf("Final frame not rendered: %q", buffer.String()))
+
+	s = createStaged(t, buffer, pbar.Stage{Name: "download", Total: 2}, pbar.Stage{Name: "load", Total: 3})
+	s.Initialize()
+	s.Update()
+	s.Update()
+
+	buffer.Reset()
+	s.Abort(nil)
+	assert.Contains(t, buffer.String(), "50.0% [cancelled, elapsed: 00m:00s]", fmt.Sprintf("Cancelled frame not rendered: %q", buffer.String()))
+	assert.Error(t, s.NextStage(), fmt.Sprintf("Expected error was not raised after Abort!"))
+}
+
+func TestStagedConcurrent(t *testing.T) {
+	pbartest.Stub(t, 150, pbartest.At(0))
+	s := createStaged(t, new(bytes.Buffer), pbar.Stage{Name: "download", Total: 50}, pbar.Stage{Name: "parse", Total: 50}, pbar.Stage{Name: "load", Total: 50})
+	s.Initialize()
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		previous := 0
+		for {
+			index, stage := s.CurrentStage()
+			assert.True(t, index >= previous, fmt.Sprintf("Stage moved back from: %v; to: %v", previous, index))
+			assert.NotEmpty(t, stage.Name, fmt.Sprintf("Stage: %v has no name", index))
+			if index == 2 {
+				return
+			}
+
+			previous = index
+		}
+	}()
+
+	for index := 0; index < 50; index++ {
+		s.Update()
+	}
+	s.NextStage()
+	wg.Wait()
+}
@@ -0,0 +1,114 @@
+/*
+ * The MIT License
+ *
+ * Copyright 2018 kinsey40.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * File:   title.go
+ * Author: kinsey40
+ *
+ * Created on 19 October 2026, 18:40
+ *
+ * Title mirrors the progress of the progress bar into the title of the
+ * terminal window or tab (OSC 0), so the progress is visible whilst the
+ * terminal is in the background. The previous title is restored once the
+ * progress bar stops.
+ *
+ */
+
+package pbar
+
+import (
+	"fmt"
+	"strings"
+)
+
+// The escape sequences which save and restore the title of the
+// terminal, and which set the title to the %s value.
+var (
+	TitlePush   = "\033[22;0t"
+	TitlePop    = "\033[23;0t"
+	TitleFormat = "\033]0;%s\007"
+)
+
+// SetTitle sets whether the progress is mirrored into the title of the
+// terminal, e.g. "42% Uploading – 01m:30s left". The title is saved
+// when the progress bar is first drawn, and restored once it finishes or
+// is aborted. Only the top progress bar of a tree sets the title.
+//
+// Default Value: false
+func (itr *Iterator) SetTitle(title bool) {
+	itr.title = title
+}
+
+// titleSequence creates the escape sequence setting the title to the
+// progress of the progress bar, saving the previous title the first
+// time. This is empty unless the title is set.
+func (itr *Iterator) titleSequence() string {
+	if !itr.title || itr.parent != nil || itr.jsonOutput != nil {
+		return ""
+	}
+
+	s := itr.snapshot()
+	title := fmt.Sprintf("%.0f%%", s.Percent)
+	if s.Description != "" {
+		title = fmt.Sprintf("%s %s", title, s.Description)
+	}
+
+	if s.Estimated && s.State == Running {
+		dash := "\u2013"
+		if itr.ascii {
+			dash = "-"
+		}
+
+		title = fmt.Sprintf("%s %s %s left", title, dash, itr.Clock.Format(s.ETA))
+	}
+
+	sequence := fmt.Sprintf(TitleFormat, sanitizeTitle(title))
+	if !itr.titleSaved {
+		itr.titleSaved = true
+		sequence = TitlePush + sequence
+	}
+
+	return sequence
+}
+
+// restoreTitle creates the escape sequence restoring the saved title
+func (itr *Iterator) restoreTitle() string {
+	if !itr.titleSaved {
+		return ""
+	}
+
+	itr.titleSaved = false
+
+	return TitlePop
+}
+
+// sanitizeTitle removes the control characters, which would
+// terminate the escape sequence, from the title.
+func sanitizeTitle(title string) string {
+	return strings.Map(func(r rune) rune {
+		if r < 0x20 || r == 0x7f {
+			return -1
+		}
+
+		return r
+	}, title)
+}
@@ -0,0 +1,90 @@
+/*
+ * The MIT License
+ *
+ * Copyright 2018 kinsey40.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * File:   title_test.go
+ * Author: kinsey40
+ *
+ * Created on 19 October 2026, 18:40
+ *
+ * The test file for title.go
+ *
+ */
+
+package pbar_test
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/kinsey40/pbar"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetTitle(t *testing.T) {
+	testCases := []struct {
+		description    string
+		unicode        bool
+		updates        int
+		abort          bool
+		expectedTitles []string
+	}{
+		{"Uploading", true, 4, false, []string{"0% Uploading", "25% Uploading \u2013 00m:06s left", "50% Uploading \u2013 00m:03s left", "75% Uploading \u2013 00m:01s left", "100% Uploading"}},
+		{"", true, 1, true, []string{"0%", "25% \u2013 00m:06s left"}},
+		{"", false, 1, true, []string{"0%", "25% - 00m:06s left"}},
+		{"Bad\007Name", true, 0, false, []string{"0% BadName"}},
+	}
+
+	for _, testCase := range testCases {
+		buffer := new(bytes.Buffer)
+		itr := createJSONBar(t, buffer, nil, 4)
+		itr.SetDescription(testCase.description)
+		itr.SetUnicode(testCase.unicode)
+		itr.SetTitle(true)
+		itr.Initialize()
+		for index := 0; index < testCase.updates; index++ {
+			itr.Update()
+		}
+
+		if testCase.abort {
+			itr.Abort(nil)
+		}
+
+		got := buffer.String()
+		titles := []string{}
+		for _, match := range regexp.MustCompile("\033\\]0;([^\007]*)\007").FindAllStringSubmatch(got, -1) {
+			titles = append(titles, match[1])
+		}
+
+		assert.Equal(t, testCase.expectedTitles, titles, fmt.Sprintf("Titles incorrect expected: %q; got: %q", testCase.expectedTitles, titles))
+		assert.Equal(t, 1, strings.Count(got, pbar.TitlePush), fmt.Sprintf("Title not saved once: %q", got))
+		if testCase.updates == 4 || testCase.abort {
+			assert.Equal(t, 1, strings.Count(got, pbar.TitlePop), fmt.Sprintf("Title not restored once: %q", got))
+			assert.True(t, strings.Index(got, pbar.TitlePop) > strings.LastIndex(got, "\033]0;"), fmt.Sprintf("Title restored before the last update: %q", got))
+		} else {
+			assert.NotContains(t, got, pbar.TitlePop, fmt.Sprintf("Title restored whilst running: %q", got))
+		}
+	}
+}
@@ -0,0 +1,251 @@
+/*
+ * The MIT License
+ *
+ * Copyright 2018 kinsey40.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * File:   tree.go
+ * Author: kinsey40
+ *
+ * Created on 19 October 2026, 15:30
+ *
+ * Tree enables progress bars to be nested, a child bar is created from its
+ * parent and rendered, indented, beneath it. The whole tree is redrawn whenever
+ * any of its bars is updated.
+ *
+ */
+
+package pbar
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kinsey40/pbar/render"
+)
+
+// FinishedChildren sets how the finished children of a
+// progress bar are displayed.
+type FinishedChildren int
+
+// KeepFinished leaves finished children in place, CollapseFinished replaces
+// them with a single summary line and RemoveFinished hides them entirely.
+const (
+	KeepFinished FinishedChildren = iota
+	CollapseFinished
+	RemoveFinished
+)
+
+// ChildIndent is the indentation applied to each level of child bars
+var ChildIndent = "  "
+
+// Child creates a progress bar nested beneath this one, from the inputted
+// total (either a number or a valid object, as with Pbar). The child shares
+// the writer, symbols and theme of its parent. It must be Initialized and
+// Updated in the same way as any other progress bar.
+func (itr *Iterator) Child(total interface{}, description string) (Iterate, error) {
+	iterate, err := Pbar(total)
+	if err != nil {
+		return nil, err
+	}
+
+	child := iterate.(*Iterator)
+	child.parent = itr
+	child.Write = itr.Write
+	child.Settings.SetPreset(itr.Settings.GetPreset())
+	child.Settings.SetTheme(itr.Settings.GetTheme())
+	child.Settings.SetColourMode(itr.Settings.GetColourMode())
+	child.jsonOutput = itr.jsonOutput
+	child.registry = itr.registry
+	child.SetDescription(description)
+	itr.children = append(itr.children, child)
+
+	return child, nil
+}
+
+// SetAggregate sets whether the progress of this bar is derived from its
+// children. Each child counts as a single step of the parent, partially
+// completed children contribute the fraction they have completed.
+// Update does not move an aggregated bar forward, it only redraws it.
+//
+// Default Value: false
+func (itr *Iterator) SetAggregate(value bool) {
+	itr.aggregate = value
+}
+
+// SetFinishedChildren sets how finished children are displayed beneath
+// this bar: kept in place, collapsed into a summary line, or removed.
+//
+// Default Value: KeepFinished
+func (itr *Iterator) SetFinishedChildren(value FinishedChildren) {
+	itr.finishedChildren = value
+}
+
+// inTree returns true if the progress bar has a parent or children
+func (itr *Iterator) inTree() bool {
+	return itr.parent != nil || len(itr.children) > 0
+}
+
+// root returns the top-most progress bar of the tree
+func (itr *Iterator) root() *Iterator {
+	root := itr
+	for root.parent != nil {
+		root = root.parent
+	}
+
+	return root
+}
+
+// depth returns the number of ancestors of the progress bar
+func (itr *Iterator) depth() int {
+	depth := 0
+	for node := itr.parent; node != nil; node = node.parent {
+		depth++
+	}
+
+	return depth
+}
+
+// refreshTree recalculates the aggregated ancestors of the progress bar and
+// then redraws the whole tree. The suffix is written once the root finishes.
+func (itr *Iterator) refreshTree() error {
+	for node := itr.parent; node != nil; node = node.parent {
+		if node.aggregate && node.state == Running && node.Clock.IsStartTimeSet() == nil {
+			node.Clock.Now()
+			node.refreshAggregate()
+			if node.jsonOutput != nil {
+				node.writeJSON()
+			}
+		}
+	}
+
+	root := itr.root()
+	if root.jsonOutput != nil {
+		return itr.writeJSON()
+	}
+
+	if err := root.redraw(); err != nil {
+		return err
+	}
+
+	if root.state != Running && !root.suffixWritten {
+		root.suffixWritten = true
+		return root.renderSuffix()
+	}
+
+	return nil
+}
+
+// refreshAggregate derives the current value of the progress bar from the
+// fraction completed by each of its children, and re-formats its line.
+func (itr *Iterator) refreshAggregate() {
+	start := itr.Values.GetStart()
+	stop := itr.Values.GetStop()
+	step := itr.Values.GetStep()
+
+	completed := 0.0
+	for _, child := range itr.children {
+		completed += child.fraction
+	}
+
+	current := start + completed*step
+	if current > stop {
+		current = stop
+	}
+
+	itr.Values.SetCurrent(current)
+	itr.line = itr.formatProgressBar(start, stop, current, itr.Settings.GetLineSize())
+	itr.fraction = fraction(start, stop, current)
+	if current == stop {
+		itr.setState(Finished)
+	}
+
+	itr.notify()
+}
+
+// redraw writes every line of the tree, moving the cursor back to the
+// top of the previously drawn tree first.
+func (itr *Iterator) redraw() error {
+	lines := itr.treeLines(0)
+	var b strings.Builder
+	b.WriteString(itr.titleSequence())
+	if itr.drawn > 1 {
+		b.WriteString(fmt.Sprintf("\033[%dA", itr.drawn-1))
+	}
+
+	for index, line := range lines {
+		if index > 0 {
+			b.WriteString("\n")
+		}
+		b.WriteString("\r" + line + "\033[K")
+	}
+
+	b.WriteString("\033[J")
+	itr.drawn = len(lines)
+
+	return itr.Write.WriteString(b.String())
+}
+
+// treeLines returns the lines of the progress bar and its children,
+// indented by their depth within the tree.
+func (itr *Iterator) treeLines(depth int) []string {
+	indent := strings.Repeat(ChildIndent, depth)
+	lines := make([]string, 0, len(itr.children)+1)
+	if itr.line != "" {
+		lines = append(lines, indent+itr.line)
+	}
+
+	collapsed := 0
+	childLines := make([]string, 0, len(itr.children))
+	for _, child := range itr.children {
+		if child.state == Aborted {
+			continue
+		}
+
+		if child.state == Finished && itr.finishedChildren != KeepFinished {
+			collapsed++
+			continue
+		}
+
+		childLines = append(childLines, child.treeLines(depth+1)...)
+	}
+
+	if collapsed > 0 && itr.finishedChildren == CollapseFinished {
+		symbol := DefaultSuccessSymbol
+		if itr.ascii {
+			symbol = ASCIISuccessSymbol
+		}
+
+		summary := fmt.Sprintf("%s %d finished", symbol, collapsed)
+		lines = append(lines, indent+ChildIndent+itr.Settings.Paint(render.FinishedSegment, summary))
+	}
+
+	return append(lines, childLines...)
+}
+
+// fraction returns the fraction of the range between start and stop
+// which has been completed by current.
+func fraction(start, stop, current float64) float64 {
+	if stop <= start {
+		return 1.0
+	}
+
+	return (current - start) / (stop - start)
+}
@@ -0,0 +1,150 @@
+/*
+ * The MIT License
+ *
+ * Copyright 2018 kinsey40.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * File:   tree_internal_test.go
+ * Author: kinsey40
+ *
+ * Created on 19 October 2026, 15:30
+ *
+ * The internal test file for tree.go
+ *
+ */
+
+package pbar
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/kinsey40/pbar/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTreeLines(t *testing.T) {
+	testCases := []struct {
+		policy        FinishedChildren
+		ascii         bool
+		states        []State
+		expectedLines []string
+	}{
+		{KeepFinished, false, []State{Finished, Running}, []string{"parent", "  one", "    grandchild", "  two"}},
+		{CollapseFinished, false, []State{Finished, Running}, []string{"parent", "  " + DefaultSuccessSymbol + " 1 finished", "  two"}},
+		{CollapseFinished, true, []State{Finished, Running}, []string{"parent", "  " + ASCIISuccessSymbol + " 1 finished", "  two"}},
+		{CollapseFinished, false, []State{Running, Running}, []string{"parent", "  one", "    grandchild", "  two"}},
+		{RemoveFinished, false, []State{Finished, Finished}, []string{"parent"}},
+		{KeepFinished, false, []State{Aborted, Running}, []string{"parent", "  two"}},
+	}
+
+	for _, testCase := range testCases {
+		parent := &Iterator{Settings: &render.Set{}, line: "parent", finishedChildren: testCase.policy, ascii: testCase.ascii}
+		one := &Iterator{parent: parent, line: "one", state: testCase.states[0]}
+		two := &Iterator{parent: parent, line: "two", state: testCase.states[1]}
+		one.children = []*Iterator{{parent: one, line: "grandchild"}}
+		parent.children = []*Iterator{one, two}
+
+		lines := parent.treeLines(0)
+		message := fmt.Sprintf("Tree lines incorrect expected: %q; got: %q", testCase.expectedLines, lines)
+
+		assert.Equal(t, testCase.expectedLines, lines, message)
+	}
+}
+
+func TestRedraw(t *testing.T) {
+	testCases := []struct {
+		drawn          int
+		expectedOutput string
+		expectedDrawn  int
+	}{
+		{0, "\rparent\033[K\n\r  child\033[K\033[J", 2},
+		{1, "\rparent\033[K\n\r  child\033[K\033[J", 2},
+		{3, "\033[2A\rparent\033[K\n\r  child\033[K\033[J", 2},
+	}
+
+	for _, testCase := range testCases {
+		buffer := new(bytes.Buffer)
+		parent := &Iterator{Write: &render.Writing{W: buffer}, line: "parent", drawn: testCase.drawn}
+		parent.children = []*Iterator{{parent: parent, line: "child"}}
+
+		err := parent.redraw()
+		got := buffer.String()
+
+		assert.NoError(t, err, fmt.Sprintf("Unexpected error raised: %v", err))
+		assert.Equal(t, testCase.expectedOutput, got, fmt.Sprintf("Output incorrect expected: %q; got: %q", testCase.expectedOutput, got))
+		assert.Equal(t, testCase.expectedDrawn, parent.drawn, fmt.Sprintf("Drawn incorrect expected: %v; got: %v", testCase.expectedDrawn, parent.drawn))
+	}
+}
+
+func TestRefreshAggregate(t *testing.T) {
+	testCases := []struct {
+		fractions       []float64
+		stop            float64
+		expectedCurrent float64
+		expectedState   State
+	}{
+		{[]float64{}, 2.0, 0.0, Running},
+		{[]float64{1.0, 0.5}, 2.0, 1.5, Running},
+		{[]float64{1.0, 1.0}, 2.0, 2.0, Finished},
+		{[]float64{1.0, 1.0, 1.0}, 2.0, 2.0, Finished},
+	}
+
+	for _, testCase := range testCases {
+		render.NowTime = func() time.Time { return time.Unix(0, 0) }
+		itr := &Iterator{
+			Values:   &render.Vals{Stop: testCase.stop, Step: 1.0},
+			Settings: &render.Set{LineSize: 4, FinishedIterationSymbol: "#", CurrentIterationSymbol: "#", RemainingIterationSymbol: "-"},
+			Clock:    &render.ClockVal{},
+		}
+
+		for _, fraction := range testCase.fractions {
+			itr.children = append(itr.children, &Iterator{parent: itr, fraction: fraction})
+		}
+
+		itr.refreshAggregate()
+
+		assert.Equal(t, testCase.expectedCurrent, itr.Values.GetCurrent(), fmt.Sprintf("Current incorrect expected: %v; got: %v", testCase.expectedCurrent, itr.Values.GetCurrent()))
+		assert.Equal(t, testCase.expectedState, itr.state, fmt.Sprintf("State incorrect expected: %v; got: %v", testCase.expectedState, itr.state))
+		assert.NotEmpty(t, itr.line, fmt.Sprintf("Line not formatted"))
+	}
+}
+
+func TestFraction(t *testing.T) {
+	testCases := []struct {
+		start            float64
+		stop             float64
+		current          float64
+		expectedFraction float64
+	}{
+		{0.0, 4.0, 1.0, 0.25},
+		{2.0, 4.0, 3.0, 0.5},
+		{2.0, 2.0, 2.0, 1.0},
+	}
+
+	for _, testCase := range testCases {
+		output := fraction(testCase.start, testCase.stop, testCase.current)
+		message := fmt.Sprintf("Fraction incorrect expected: %v; got: %v", testCase.expectedFraction, output)
+
+		assert.Equal(t, testCase.expectedFraction, output, message)
+	}
+}
@@ -0,0 +1,140 @@
+/*
+ * The MIT License
+ *
+ * Copyright 2018 kinsey40.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * File:   tree_test.go
+ * Author: kinsey40
+ *
+ * Created on 19 October 2026, 15:30
+ *
+ * The test file for tree.go
+ *
+ */
+
+package pbar_test
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/kinsey40/pbar"
+	"github.com/kinsey40/pbar/internal/pbartest"
+	"github.com/kinsey40/pbar/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChild(t *testing.T) {
+	testCases := []struct {
+		total       interface{}
+		expectError bool
+	}{
+		{3, false},
+		{[]int{1, 2, 3}, false},
+		{complex128(1), true},
+	}
+
+	for _, testCase := range testCases {
+		p, _ := pbar.Pbar(3)
+		p.SetPreset("classic")
+		child, err := p.Child(testCase.total, "Child")
+		if testCase.expectError {
+			assert.Error(t, err, fmt.Sprintf("Expected error was not raised!"))
+			assert.Nil(t, child, fmt.Sprintf("Child is not nil (%v)", child))
+		} else {
+			c := child.(*pbar.Iterator)
+			assert.NoError(t, err, fmt.Sprintf("Unexpected error(%v) was raised!", err))
+			assert.Equal(t, p.(*pbar.Iterator).Write, c.Write, fmt.Sprintf("Child does not share the parents writer"))
+			assert.Equal(t, render.ClassicPreset, c.Settings.GetPreset(), fmt.Sprintf("Child does not share the parents symbols"))
+			assert.Equal(t, "Child:", c.Settings.GetDescription(), fmt.Sprintf("Child description incorrect: %v", c.Settings.GetDescription()))
+		}
+	}
+}
+
+func TestChildLineSize(t *testing.T) {
+	testCases := []struct {
+		width       int
+		depth       int
+		expectedBar string
+	}{
+		{80, 1, "||"},
+		{80, 3, "||"},
+		{100, 1, "|" + strings.Repeat("#", 9) + strings.Repeat(".", 9) + "|"},
+		{150, 2, "|" + strings.Repeat("#", 33) + strings.Repeat(".", 33) + "|"},
+	}
+
+	for _, testCase := range testCases {
+		pbartest.Stub(t, testCase.width, pbartest.At(0))
+
+		buffer := new(bytes.Buffer)
+		p, _ := pbar.Pbar(2)
+		itr := p.(*pbar.Iterator)
+		itr.Write = &render.Writing{W: buffer}
+		itr.SetUnicode(false)
+		itr.SetCurrentIterationSymbol("#")
+		itr.SetRemainingIterationSymbol(".")
+		p.Initialize()
+
+		child := p
+		for depth := 0; depth < testCase.depth; depth++ {
+			child, _ = child.Child(2, "")
+		}
+
+		err := child.Initialize()
+		child.Update()
+
+		expected := fmt.Sprintf("\r%s%s 1.0/2.0 50.0%%", strings.Repeat(pbar.ChildIndent, testCase.depth), testCase.expectedBar)
+		assert.NoError(t, err, fmt.Sprintf("Unexpected error(%v) raised at width: %v", err, testCase.width))
+		assert.Contains(t, buffer.String(), expected, fmt.Sprintf("Line expected to contain: %q at width: %v; got: %q", expected, testCase.width, buffer.String()))
+	}
+}
+
+func TestAggregatedTree(t *testing.T) {
+	buffer := new(bytes.Buffer)
+	parent := pbartest.NewBar(t, buffer, pbartest.At(0), 2)
+	parent.SetDescription("Parent")
+	parent.SetAggregate(true)
+	parent.SetFinishedChildren(pbar.CollapseFinished)
+	parent.Initialize()
+
+	for index := 0; index < 2; index++ {
+		child, _ := parent.Child(2, fmt.Sprintf("Child%d", index))
+		child.Initialize()
+
+		child.Update()
+		assert.Equal(t, float64(index)+0.5, parent.Values.GetCurrent(), fmt.Sprintf("Parent current incorrect: %v", parent.Values.GetCurrent()))
+
+		child.Update()
+		assert.Equal(t, float64(index)+1.0, parent.Values.GetCurrent(), fmt.Sprintf("Parent current incorrect: %v", parent.Values.GetCurrent()))
+	}
+
+	got := buffer.String()
+	frames := strings.Split(got, "\033[J")
+	lastFrame := frames[len(frames)-2]
+
+	assert.Contains(t, lastFrame, "Parent:", fmt.Sprintf("Parent missing from final frame: %q", lastFrame))
+	assert.Contains(t, lastFrame, "2.0/2.0 100.0%", fmt.Sprintf("Parent not complete in final frame: %q", lastFrame))
+	assert.Contains(t, lastFrame, "2 finished", fmt.Sprintf("Children not collapsed in final frame: %q", lastFrame))
+	assert.NotContains(t, lastFrame, "Child1:", fmt.Sprintf("Finished child in final frame: %q", lastFrame))
+	assert.True(t, strings.HasSuffix(got, "\033[J\r\n"), fmt.Sprintf("Suffix not written at the end: %q", got))
+}